@@ -0,0 +1,118 @@
+package analysis
+
+import (
+	"sort"
+)
+
+// OpeningHand is how many cards Game.Start deals every player before the first turn
+const OpeningHand = 3
+
+// FatigueHorizon is how many of their own turns a player lasts once their deck runs out, 30 health
+// burning 1 a turn
+const FatigueHorizon = 30
+
+// Deck is a deck list with what its cards cost and how much damage they deal
+type Deck struct {
+	Cards  []int
+	Cost   func(card int) int
+	Damage func(card int) int
+}
+
+// Curve counts the deck's cards at each cost
+func (d Deck) Curve() map[int]int {
+	curve := map[int]int{}
+	for _, c := range d.Cards {
+		curve[d.Cost(c)]++
+	}
+	return curve
+}
+
+// Costs are the costs in the deck, lowest first
+func (d Deck) Costs() []int {
+	costs := []int{}
+	for cost := range d.Curve() {
+		costs = append(costs, cost)
+	}
+	sort.Ints(costs)
+	return costs
+}
+
+// Seen is how many cards a player has drawn by the start of their own turn, counting from 1 for their first
+func (d Deck) Seen(turn int) int {
+	return min(OpeningHand+turn, len(d.Cards))
+}
+
+// Chance is the probability of having drawn at least one card that matches by the player's turn
+func (d Deck) Chance(match func(card int) bool, turn int) float64 {
+	matching := 0
+	for _, c := range d.Cards {
+		if match(c) {
+			matching++
+		}
+	}
+	return AtLeastOne(len(d.Cards), matching, d.Seen(turn))
+}
+
+// DamagePerMana is all the damage in the deck over all it costs to play
+func (d Deck) DamagePerMana() float64 {
+	damage, cost := 0, 0
+	for _, c := range d.Cards {
+		damage += d.Damage(c)
+		cost += d.Cost(c)
+	}
+	if cost == 0 {
+		return 0
+	}
+	return float64(damage) / float64(cost)
+}
+
+// TurnToDeal estimates the player's own turn they've dealt target damage by if nobody gets in their way,
+// mana is what they have on each of their turns. Each turn adds its mana's worth of damage at the deck's
+// damage per mana, but never more than the average damage of the cards drawn so far. It's 0 for never,
+// when the deck doesn't have target damage in it or it isn't dealt before the player burns out.
+func (d Deck) TurnToDeal(target int, mana func(turn int) int) int {
+	total := 0
+	for _, c := range d.Cards {
+		total += d.Damage(c)
+	}
+	if total < target || total == 0 {
+		return 0
+	}
+	perMana := d.DamagePerMana()
+	perCard := float64(total) / float64(len(d.Cards))
+	spent := 0
+	for turn := 1; turn <= len(d.Cards)+FatigueHorizon; turn++ {
+		spent += mana(turn)
+		dealt := float64(spent) * perMana
+		if drawn := float64(d.Seen(turn)) * perCard; drawn < dealt {
+			dealt = drawn
+		}
+		if dealt >= float64(target)-1e-9 {
+			return turn
+		}
+	}
+	return 0
+}
+
+// AtLeastOne is the hypergeometric probability of drawing at least one of successes cards in draws from a
+// population of cards
+func AtLeastOne(population, successes, draws int) float64 {
+	if draws > population {
+		draws = population
+	}
+	none := 1.0
+	for i := 0; i < draws; i++ {
+		if population-successes-i <= 0 {
+			return 1
+		}
+		none *= float64(population-successes-i) / float64(population-i)
+	}
+	return 1 - none
+}
+
+func min(i, j int) int {
+	if i < j {
+		return i
+	}
+	return j
+}
@@ -0,0 +1,81 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func value(card int) int {
+	return card
+}
+
+func newDeck(cards ...int) Deck {
+	return Deck{Cards: cards, Cost: value, Damage: value}
+}
+
+func TestDeck_Curve(t *testing.T) {
+	d := newDeck(1, 1, 2, 5, 5, 5)
+	assert.Equal(t, map[int]int{1: 2, 2: 1, 5: 3}, d.Curve())
+	assert.Equal(t, []int{1, 2, 5}, d.Costs())
+}
+
+func TestAtLeastOne(t *testing.T) {
+	tests := []struct {
+		name       string
+		population int
+		successes  int
+		draws      int
+		want       float64
+	}{
+		{name: "one of two in one draw", population: 2, successes: 1, draws: 1, want: 0.5},
+		{name: "one copy in 4 of 20", population: 20, successes: 1, draws: 4, want: 0.2},
+		{name: "two copies in 4 of 20", population: 20, successes: 2, draws: 4, want: 1 - 16.0/20*15/19},
+		{name: "none in the deck", population: 20, draws: 20},
+		{name: "more draws than misses", population: 5, successes: 3, draws: 3, want: 1},
+		{name: "draws past the deck", population: 3, successes: 1, draws: 10, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, AtLeastOne(tt.population, tt.successes, tt.draws), 1e-9)
+		})
+	}
+}
+
+func TestDeck_Chance(t *testing.T) {
+	d := newDeck(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+	assert.Equal(t, 4, d.Seen(1), "3 dealt and 1 drawn for the turn")
+	assert.Equal(t, 10, d.Seen(9))
+	assert.InDelta(t, 0.4, d.Chance(func(c int) bool { return c == 8 }, 1), 1e-9)
+	assert.InDelta(t, 1, d.Chance(func(c int) bool { return c == 8 }, 7), 1e-9)
+}
+
+func TestDeck_DamagePerMana(t *testing.T) {
+	d := Deck{Cards: []int{2, 2, 11}, Cost: value, Damage: func(card int) int {
+		if card > 10 {
+			return 0
+		}
+		return card
+	}}
+	assert.InDelta(t, 4.0/15, d.DamagePerMana(), 1e-9)
+	assert.Equal(t, 0.0, Deck{}.DamagePerMana())
+}
+
+func TestDeck_TurnToDeal(t *testing.T) {
+	tests := []struct {
+		name   string
+		deck   Deck
+		target int
+		want   int
+	}{
+		{name: "limited by mana", deck: newDeck(1, 1, 1, 1, 1, 1, 1, 1, 1, 1), target: 6, want: 3},
+		{name: "limited by cards drawn", deck: newDeck(1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1), target: 10, want: 7},
+		{name: "not enough damage", deck: newDeck(1, 2), target: 30},
+		{name: "never dealt", deck: Deck{Cards: []int{1, 2}, Cost: func(int) int { return 0 }, Damage: value}, target: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.deck.TurnToDeal(tt.target, func(turn int) int { return turn }))
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package campaign
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+)
+
+// Behavior is how a boss chooses which card to play
+type Behavior string
+
+const (
+	// Aggressive plays the most expensive card it can afford first
+	Aggressive Behavior = "aggressive"
+	// Thrifty plays the cheapest card first
+	Thrifty Behavior = "thrifty"
+)
+
+// behaviors says whether a is preferred over b for each behavior
+var behaviors = map[Behavior]func(a, b int) bool{
+	Aggressive: func(a, b int) bool { return a > b },
+	Thrifty:    func(a, b int) bool { return a < b },
+}
+
+// Trigger happens at the start of the boss's nth turn
+type Trigger struct {
+	Turn int    `json:"turn"`
+	Say  string `json:"say"`
+	// Damage is dealt to every one of the boss's opponents
+	Damage int `json:"damage"`
+}
+
+// Hero is a player the boss can see the hand and mana of
+type Hero interface {
+	game.Player
+	GetMana() int
+	ShowHand() []int
+}
+
+// Boss plays a hero's turns itself instead of asking for input
+type Boss struct {
+	hero   Hero
+	prefer func(a, b int) bool
+	script []Trigger
+	turns  int
+	// playedFrom is the hand size when the boss last played, -1 when it hasn't this turn
+	playedFrom int
+	cost       func(card int) int
+}
+
+func NewBoss(hero Hero, behavior Behavior, script []Trigger) *Boss {
+	prefer, ok := behaviors[behavior]
+	if !ok {
+		prefer = behaviors[Aggressive]
+	}
+	return &Boss{
+		hero:   hero,
+		prefer: prefer,
+		script: script,
+		cost:   func(card int) int { return card },
+	}
+}
+
+// SetCardCost is for cards that don't cost their value, like secrets
+func (b *Boss) SetCardCost(cost func(card int) int) {
+	b.cost = cost
+}
+
+// Wrap plays the boss's turns, everyone else's go through turn as normal
+func (b *Boss) Wrap(turn game.TurnFunc) game.TurnFunc {
+	return func(iter int, active game.Player, table *game.Table, getInput func() string) *game.Result {
+		if active.ID() != b.hero.ID() {
+			return turn(iter, active, table, getInput)
+		}
+		b.turns++
+		b.playedFrom = -1
+		for _, t := range b.script {
+			if t.Turn != b.turns {
+				continue
+			}
+			if t.Say != "" {
+				fmt.Fprintf(game.Output(), "%s: %q\n", b.hero.ID(), t.Say)
+			}
+			if t.Damage > 0 {
+				for _, o := range table.Opponents(active) {
+					o.ApplyDamage(t.Damage)
+				}
+				if result := table.Settle(game.ReasonKilled); result != nil {
+					return result
+				}
+			}
+		}
+		return turn(iter, active, table, b.input)
+	}
+}
+
+// input picks a card by the boss's behavior and ends the turn when nothing is affordable. A play
+// always shrinks the hand, so if it hasn't the game refused the card and the boss gives up.
+func (b *Boss) input() string {
+	hand := b.hero.ShowHand()
+	if b.playedFrom == len(hand) {
+		return "end"
+	}
+	best := -1
+	for i, c := range hand {
+		if b.cost(c) <= b.hero.GetMana() && (best == -1 || b.prefer(b.cost(c), b.cost(hand[best]))) {
+			best = i
+		}
+	}
+	if best == -1 {
+		return "end"
+	}
+	b.playedFrom = len(hand)
+	return strconv.Itoa(best)
+}
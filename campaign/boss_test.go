@@ -0,0 +1,139 @@
+package campaign
+
+import (
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeHero struct {
+	id     string
+	health int
+	mana   int
+	hand   []int
+}
+
+func (f *fakeHero) ApplyDamage(d int) { f.health -= d }
+func (f *fakeHero) GetHealth() int    { return f.health }
+func (f *fakeHero) SetMana(m int)     { f.mana = m }
+func (f *fakeHero) PlayCard(index int) (int, error) {
+	v := f.hand[index]
+	f.hand = append(f.hand[:index], f.hand[index+1:]...)
+	f.mana -= v
+	return v, nil
+}
+func (f *fakeHero) IsDead() bool    { return f.health <= 0 }
+func (f *fakeHero) Draw() error     { return nil }
+func (f *fakeHero) ID() string      { return f.id }
+func (f *fakeHero) PrintStats()     {}
+func (f *fakeHero) GetMana() int    { return f.mana }
+func (f *fakeHero) ShowHand() []int { return f.hand }
+
+// playAll is a turn that keeps asking for input until told to end, playing bare indices
+func playAll(iter int, active game.Player, table *game.Table, getInput func() string) *game.Result {
+	played := []string{}
+	for {
+		s := getInput()
+		if s == "end" {
+			return &game.Result{Winners: played}
+		}
+		played = append(played, s)
+		var i int
+		for _, c := range s {
+			i = i*10 + int(c-'0')
+		}
+		active.PlayCard(i)
+	}
+}
+
+func TestBoss_input(t *testing.T) {
+	tests := []struct {
+		name     string
+		behavior Behavior
+		mana     int
+		hand     []int
+		want     []string
+	}{
+		{
+			name:     "aggressive plays the biggest affordable card",
+			behavior: Aggressive,
+			mana:     5,
+			hand:     []int{1, 4, 6, 2},
+			want:     []string{"1", "0"},
+		},
+		{
+			name:     "thrifty plays the cheapest first",
+			behavior: Thrifty,
+			mana:     5,
+			hand:     []int{3, 1, 6, 2},
+			want:     []string{"1", "2"},
+		},
+		{
+			name:     "nothing affordable",
+			behavior: Aggressive,
+			mana:     0,
+			hand:     []int{3},
+			want:     []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boss := &fakeHero{id: "boss", health: 10, mana: tt.mana, hand: tt.hand}
+			table := game.NewTable([]game.Player{boss, &fakeHero{id: "p1", health: 10}}, nil)
+			result := NewBoss(boss, tt.behavior, nil).Wrap(playAll)(1, boss, table, nil)
+			assert.Equal(t, tt.want, result.Winners)
+		})
+	}
+}
+
+func TestBoss_inputPaysCardCosts(t *testing.T) {
+	boss := &fakeHero{id: "boss", health: 10, mana: 2, hand: []int{5, 12}}
+	table := game.NewTable([]game.Player{boss, &fakeHero{id: "p1", health: 10}}, nil)
+	b := NewBoss(boss, Aggressive, nil)
+	b.SetCardCost(func(card int) int {
+		if card == 12 {
+			return 2
+		}
+		return card
+	})
+	result := b.Wrap(playAll)(1, boss, table, nil)
+	assert.Equal(t, []string{"1"}, result.Winners, "the secret costs 2 and the 5 is out of reach")
+}
+
+func TestBoss_inputGivesUpWhenRefused(t *testing.T) {
+	boss := &fakeHero{id: "boss", mana: 5, hand: []int{2}}
+	b := NewBoss(boss, Aggressive, nil)
+	b.playedFrom = -1
+	assert.Equal(t, "0", b.input())
+	assert.Equal(t, "end", b.input(), "the hand didn't shrink so the play was refused")
+}
+
+func TestBoss_Wrap(t *testing.T) {
+	boss := &fakeHero{id: "boss", health: 10}
+	challenger := &fakeHero{id: "p1", health: 10}
+	script := []Trigger{
+		{Turn: 1, Say: "hello"},
+		{Turn: 2, Damage: 4},
+		{Turn: 3, Damage: 6},
+	}
+	b := NewBoss(boss, Aggressive, script)
+	table := game.NewTable([]game.Player{challenger, boss}, nil)
+	humanTurns := 0
+	turn := b.Wrap(func(iter int, active game.Player, table *game.Table, getInput func() string) *game.Result {
+		if active == challenger {
+			humanTurns++
+			return nil
+		}
+		assert.Equal(t, "end", getInput())
+		return nil
+	})
+
+	assert.Nil(t, turn(1, challenger, table, nil))
+	assert.Equal(t, 1, humanTurns, "other players' turns are passed through")
+	assert.Nil(t, turn(2, boss, table, nil))
+	assert.Nil(t, turn(4, boss, table, nil))
+	assert.Equal(t, 6, challenger.health)
+	result := turn(6, boss, table, nil)
+	assert.Equal(t, &game.Result{Winners: []string{"boss"}, Losers: []string{"p1"}, Reason: game.ReasonKilled}, result)
+}
@@ -0,0 +1,120 @@
+package campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/hero"
+)
+
+// Campaign is a series of boss fights played in order
+type Campaign struct {
+	Name       string      `json:"name"`
+	Encounters []Encounter `json:"encounters"`
+}
+
+type Encounter struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Intro        string    `json:"intro"`
+	PlayerHealth int       `json:"playerHealth"`
+	Boss         BossSpec  `json:"boss"`
+	Rules        RulesSpec `json:"rules"`
+	Script       []Trigger `json:"script"`
+}
+
+type BossSpec struct {
+	Name     string   `json:"name"`
+	Health   int      `json:"health"`
+	Deck     []int    `json:"deck"`
+	Behavior Behavior `json:"behavior"`
+	// StartingHand is dealt to the boss before the opening draw
+	StartingHand []int `json:"startingHand"`
+	GoesFirst    bool  `json:"goesFirst"`
+}
+
+// RulesSpec changes the encounter's ruleset, zero values keep the defaults
+type RulesSpec struct {
+	MaxMana    int `json:"maxMana"`
+	BurnDamage int `json:"burnDamage"`
+	// BossExtraMana is added to the boss's mana every turn
+	BossExtraMana int `json:"bossExtraMana"`
+}
+
+// Ruleset is the game ruleset for the encounter, base is the live ruleset with its secrets, effects
+// and card costs
+func (e Encounter) Ruleset(base game.Ruleset) game.Ruleset {
+	r := base
+	if e.Rules.MaxMana > 0 {
+		r.MaxMana = e.Rules.MaxMana
+	}
+	if e.Rules.BurnDamage > 0 {
+		r.BurnDamage = e.Rules.BurnDamage
+	}
+	if e.Rules.BossExtraMana != 0 {
+		r.ExtraMana = map[string]int{}
+		for id, mana := range base.ExtraMana {
+			r.ExtraMana[id] = mana
+		}
+		r.ExtraMana[e.Boss.Name] += e.Rules.BossExtraMana
+	}
+	return r
+}
+
+// Load reads a campaign from a JSON data file
+func Load(path string) (*Campaign, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &Campaign{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("reading campaign %s: %v", path, err)
+	}
+	if err := c.validate(); err != nil {
+		return nil, fmt.Errorf("campaign %s: %v", path, err)
+	}
+	return c, nil
+}
+
+func (c *Campaign) validate() error {
+	if len(c.Encounters) == 0 {
+		return fmt.Errorf("no encounters")
+	}
+	seen := map[string]bool{}
+	for i, e := range c.Encounters {
+		switch {
+		case e.ID == "":
+			return fmt.Errorf("encounter %d has no id", i)
+		case seen[e.ID]:
+			return fmt.Errorf("encounter id %q is used twice", e.ID)
+		case e.Boss.Name == "":
+			return fmt.Errorf("encounter %q has no boss name", e.ID)
+		case e.Boss.Health <= 0 || e.PlayerHealth <= 0:
+			return fmt.Errorf("encounter %q needs positive health for both heroes", e.ID)
+		case len(e.Boss.Deck) < 3:
+			return fmt.Errorf("encounter %q needs a boss deck of at least 3 cards", e.ID)
+		}
+		if _, ok := behaviors[e.Boss.Behavior]; !ok {
+			return fmt.Errorf("encounter %q has unknown boss behavior %q", e.ID, e.Boss.Behavior)
+		}
+		// bosses have no class, so class cards would only be plain damage in their hands
+		if err := hero.CheckDeck("", append(append([]int{}, e.Boss.Deck...), e.Boss.StartingHand...)); err != nil {
+			return fmt.Errorf("encounter %q boss can't use its cards: %v", e.ID, err)
+		}
+		seen[e.ID] = true
+	}
+	return nil
+}
+
+// Next returns the first encounter that hasn't been cleared, nil once the campaign is complete
+func (c *Campaign) Next(p *Progress) *Encounter {
+	for i := range c.Encounters {
+		if !p.IsCleared(c.Encounters[i].ID) {
+			return &c.Encounters[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package campaign
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/effect"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/secret"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad_ShippedCampaign(t *testing.T) {
+	c, err := Load(filepath.Join("..", "data", "campaign.json"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, c.Encounters)
+	base := game.DefaultRuleset()
+	base.Secrets = secret.Cards()
+	base.Effects = effect.Cards(effect.DefaultPool(), rand.Intn)
+	for _, e := range c.Encounters {
+		r := e.Ruleset(base)
+		for _, card := range e.Boss.Deck {
+			_, isSecret := r.Secrets[card]
+			_, isEffect := r.Effects[card]
+			assert.True(t, card <= 8 || isSecret || isEffect, "%s's %d is only plain damage", e.ID, card)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	boss := `"boss": {"name": "B", "health": 10, "behavior": "aggressive", "deck": [1, 2, 3]}`
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `{"encounters": [{"id": "a", "playerHealth": 30, ` + boss + `}]}`,
+		},
+		{
+			name:    "not json",
+			data:    `{`,
+			wantErr: "reading campaign",
+		},
+		{
+			name:    "empty",
+			data:    `{"encounters": []}`,
+			wantErr: "no encounters",
+		},
+		{
+			name:    "duplicate id",
+			data:    `{"encounters": [{"id": "a", "playerHealth": 30, ` + boss + `}, {"id": "a", "playerHealth": 30, ` + boss + `}]}`,
+			wantErr: `encounter id "a" is used twice`,
+		},
+		{
+			name:    "no health",
+			data:    `{"encounters": [{"id": "a", ` + boss + `}]}`,
+			wantErr: "needs positive health",
+		},
+		{
+			name:    "small deck",
+			data:    `{"encounters": [{"id": "a", "playerHealth": 30, "boss": {"name": "B", "health": 10, "behavior": "aggressive", "deck": [1]}}]}`,
+			wantErr: "boss deck of at least 3 cards",
+		},
+		{
+			name:    "class card",
+			data:    `{"encounters": [{"id": "a", "playerHealth": 30, "boss": {"name": "B", "health": 10, "behavior": "aggressive", "deck": [1, 2, 10]}}]}`,
+			wantErr: "10 is a warrior card",
+		},
+		{
+			name:    "unknown behavior",
+			data:    `{"encounters": [{"id": "a", "playerHealth": 30, "boss": {"name": "B", "health": 10, "behavior": "sleepy", "deck": [1, 2, 3]}}]}`,
+			wantErr: `unknown boss behavior "sleepy"`,
+		},
+	}
+	dir, err := ioutil.TempDir("", "campaign")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "campaign.json")
+			if err := ioutil.WriteFile(path, []byte(tt.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := Load(path)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEncounter_Ruleset(t *testing.T) {
+	secrets := map[int]game.Secret{12: {Name: "Trap"}}
+	base := game.Ruleset{MaxMana: 10, BurnDamage: 1, ExtraMana: map[string]int{"p1": 1}, Secrets: secrets}
+	tests := []struct {
+		name  string
+		rules RulesSpec
+		want  game.Ruleset
+	}{
+		{
+			name: "defaults",
+			want: base,
+		},
+		{
+			name:  "overrides",
+			rules: RulesSpec{MaxMana: 12, BurnDamage: 3, BossExtraMana: 1},
+			want:  game.Ruleset{MaxMana: 12, BurnDamage: 3, ExtraMana: map[string]int{"p1": 1, "Lich": 1}, Secrets: secrets},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := Encounter{Boss: BossSpec{Name: "Lich"}, Rules: tt.rules}
+			assert.Equal(t, tt.want, e.Ruleset(base))
+			assert.Equal(t, map[string]int{"p1": 1}, base.ExtraMana, "the live ruleset isn't changed")
+		})
+	}
+}
+
+func TestCampaign_Next(t *testing.T) {
+	c := &Campaign{Encounters: []Encounter{{ID: "a"}, {ID: "b"}}}
+	p := &Progress{}
+	assert.Equal(t, "a", c.Next(p).ID)
+	p.Clear("a")
+	assert.Equal(t, "b", c.Next(p).ID)
+	p.Clear("b")
+	assert.Nil(t, c.Next(p))
+}
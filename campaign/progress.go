@@ -0,0 +1,61 @@
+package campaign
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+)
+
+// Progress is which encounters a player has beaten
+type Progress struct {
+	Cleared []string `json:"cleared"`
+}
+
+func (p *Progress) IsCleared(id string) bool {
+	for _, c := range p.Cleared {
+		if c == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Progress) Clear(id string) {
+	if !p.IsCleared(id) {
+		p.Cleared = append(p.Cleared, id)
+	}
+}
+
+type ProgressStore struct {
+	path string
+}
+
+func NewProgressStore(path string) *ProgressStore {
+	return &ProgressStore{
+		path: path,
+	}
+}
+
+// Load returns fresh progress when nothing has been saved yet
+func (s *ProgressStore) Load() (*Progress, error) {
+	b, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return &Progress{Cleared: []string{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	p := &Progress{Cleared: []string{}}
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (s *ProgressStore) Save(p *Progress) error {
+	b, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(s.path, b, 0644)
+}
@@ -0,0 +1,38 @@
+package campaign
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgress_Clear(t *testing.T) {
+	p := &Progress{}
+	assert.False(t, p.IsCleared("a"))
+	p.Clear("a")
+	p.Clear("a")
+	assert.True(t, p.IsCleared("a"))
+	assert.Equal(t, []string{"a"}, p.Cleared)
+}
+
+func TestProgressStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "progress")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s := NewProgressStore(filepath.Join(dir, "progress.json"))
+
+	p, err := s.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, &Progress{Cleared: []string{}}, p)
+
+	p.Clear("gatekeeper")
+	assert.NoError(t, s.Save(p))
+	got, err := s.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, p, got)
+}
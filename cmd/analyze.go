@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"github.com/ShookieShookie/WorkshopImpl/analysis"
+	"github.com/ShookieShookie/WorkshopImpl/effect"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/secret"
+	"sort"
+	"strings"
+)
+
+// analyzeTurns are the player's own turns draw chances are shown for
+const analyzeTurns = 6
+
+// analyze prints the math of a deck list, "analyze N,N,..." or the original deck with no list. It assumes
+// a two player game going first, where the player's own turns have 1, 3, 5... mana.
+func analyze(ruleset game.Ruleset, args []string) error {
+	cards := originalDeck
+	if len(args) > 0 {
+		var err error
+		if cards, err = parseCards(args[0]); err != nil {
+			return err
+		}
+	}
+	secrets := secret.Cards()
+	d := analysis.Deck{Cards: cards, Cost: effect.Cost, Damage: func(card int) int {
+		// secrets and effects don't deal their value, what they do depends on the game
+		if _, ok := secrets[card]; ok {
+			return 0
+		}
+		if _, ok := ruleset.Effects[card]; ok {
+			return 0
+		}
+		return card
+	}}
+	fmt.Println("Mana curve")
+	curve := d.Curve()
+	for _, cost := range d.Costs() {
+		fmt.Printf("%2d | %-20s %d\n", cost, strings.Repeat("#", curve[cost]), curve[cost])
+	}
+
+	fmt.Printf("\nChance of having drawn it by turn, %d dealt and 1 drawn each turn\n", analysis.OpeningHand)
+	fmt.Printf("%-8s", "")
+	for turn := 1; turn <= analyzeTurns; turn++ {
+		fmt.Printf(" %5d", turn)
+	}
+	fmt.Println()
+	row := func(name string, match func(card int) bool) {
+		fmt.Printf("%-8s", name)
+		for turn := 1; turn <= analyzeTurns; turn++ {
+			fmt.Printf(" %4.0f%%", 100*d.Chance(match, turn))
+		}
+		fmt.Println()
+	}
+	distinct := append([]int{}, cards...)
+	sort.Ints(distinct)
+	for i, card := range distinct {
+		if i > 0 && distinct[i-1] == card {
+			continue
+		}
+		card := card
+		row(fmt.Sprintf("card %d", card), func(c int) bool { return c == card })
+	}
+	for _, cost := range d.Costs() {
+		cost := cost
+		row(fmt.Sprintf("cost %d", cost), func(c int) bool { return d.Cost(c) == cost })
+	}
+
+	fmt.Printf("\nAverage damage per mana: %.2f\n", d.DamagePerMana())
+	turn := d.TurnToDeal(30, func(turn int) int {
+		if mana := 2*turn - 1; mana < ruleset.MaxMana {
+			return mana
+		}
+		return ruleset.MaxMana
+	})
+	if turn == 0 {
+		fmt.Println("The deck can't deal 30 damage before it burns out")
+		return nil
+	}
+	fmt.Printf("Expected turn to deal 30 damage uncontested: %d\n", turn)
+	return nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"github.com/ShookieShookie/WorkshopImpl/campaign"
+	"github.com/ShookieShookie/WorkshopImpl/draft"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/hand"
+	"github.com/ShookieShookie/WorkshopImpl/player"
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+// arena has player1 draft a deck and play it against freshly drafted decks the boss AI plays until the run
+// is over
+func arena(f frontend, deckSize, maxWins, maxLosses int) error {
+	rand.Seed(time.Now().UnixNano())
+	picks := draft.NewDraft(draft.DefaultCatalog(), deckSize, rand.Intn)
+	for !picks.Done() {
+		offer, err := picks.Offer()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(f.out, "Pick %d of %d:", picks.Picked()+1, deckSize)
+		for i, c := range offer {
+			fmt.Fprintf(f.out, "  [%d] %s", i, c)
+		}
+		fmt.Fprintln(f.out)
+		s := f.choose()
+		if s == game.Disconnected {
+			return nil
+		}
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			fmt.Fprintln(f.out, "Enter the number of the card you want")
+			continue
+		}
+		if err := picks.Pick(i); err != nil {
+			fmt.Fprintln(f.out, err)
+		}
+	}
+	fmt.Fprintf(f.out, "Your deck: %v\n", picks.Cards())
+
+	run := draft.NewRun(maxWins, maxLosses)
+	for !run.Over() {
+		opponent := draft.NewDraft(draft.DefaultCatalog(), deckSize, rand.Intn)
+		if err := opponent.AutoPick(); err != nil {
+			return err
+		}
+		p1 := player.NewPlayer("player1", 30, 0, hand.NewHand(), picks.NewDeck(rand.Intn))
+		p2 := player.NewPlayer("arena", 30, 0, hand.NewHand(), opponent.NewDeck(rand.Intn))
+		turn := campaign.NewBoss(p2, campaign.Aggressive, nil).Wrap(f.turn)
+		result := game.NewGame(p1, p2, f.input, turn).Start()
+		switch {
+		case result == nil:
+			return fmt.Errorf("arena decks need at least 3 cards, got %d", deckSize)
+		case result.Reason == game.ReasonDisconnect:
+			return nil
+		case result.Draw:
+			fmt.Fprintln(f.out, "Draws don't count towards the run")
+		default:
+			run.Record(result.Winners[0] == p1.ID())
+		}
+		fmt.Fprintf(f.out, "Arena run: %d wins, %d losses\n", run.Wins, run.Losses)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"github.com/ShookieShookie/WorkshopImpl/campaign"
+	"github.com/ShookieShookie/WorkshopImpl/deck"
+	"github.com/ShookieShookie/WorkshopImpl/effect"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/hand"
+	"github.com/ShookieShookie/WorkshopImpl/player"
+	"math/rand"
+	"time"
+)
+
+// playCampaign fights the next encounter player1 hasn't cleared, "campaign reset" starts over
+func playCampaign(path string, store *campaign.ProgressStore, args []string, ruleset game.Ruleset, rulesTurn func(game.Ruleset) game.TurnFunc, start func(game.TurnFunc, func(frontend) error) error) error {
+	if len(args) > 0 && args[0] == "reset" {
+		return store.Save(&campaign.Progress{Cleared: []string{}})
+	}
+	c, err := campaign.Load(path)
+	if err != nil {
+		return err
+	}
+	progress, err := store.Load()
+	if err != nil {
+		return err
+	}
+	enc := c.Next(progress)
+	if enc == nil {
+		fmt.Printf("%s is complete, run \"campaign reset\" to play it again\n", c.Name)
+		return nil
+	}
+	rand.Seed(time.Now().UnixNano())
+	boss := newBossHero(enc.Boss)
+	ai := campaign.NewBoss(boss, enc.Boss.Behavior, enc.Script)
+	ai.SetCardCost(effect.Cost)
+	turn := ai.Wrap(rulesTurn(enc.Ruleset(ruleset)))
+	return start(turn, func(f frontend) error {
+		fmt.Fprintf(f.out, "%s (%d of %d)\n%s\n", enc.Name, len(progress.Cleared)+1, len(c.Encounters), enc.Intro)
+		d := deck.NewDeck(rand.Intn)
+		for _, card := range originalDeck {
+			d.Add(card)
+		}
+		p1 := player.NewPlayer("player1", enc.PlayerHealth, 0, hand.NewHand(), d)
+		p1.SetCardCost(effect.Cost)
+		g := game.NewGame(p1, boss, f.input, f.turn)
+		if enc.Boss.GoesFirst {
+			g = game.NewGame(boss, p1, f.input, f.turn)
+		}
+		result := g.Start()
+		if result == nil || result.Draw || result.Winners[0] != p1.ID() {
+			fmt.Fprintf(f.out, "%s stands, try again\n", enc.Boss.Name)
+			return nil
+		}
+		progress.Clear(enc.ID)
+		fmt.Fprintf(f.out, "%s cleared!\n", enc.Name)
+		return store.Save(progress)
+	})
+}
+
+func newBossHero(spec campaign.BossSpec) *player.PlayerImpl {
+	d := deck.NewDeck(rand.Intn)
+	for _, card := range spec.Deck {
+		d.Add(card)
+	}
+	h := hand.NewHand()
+	for _, card := range spec.StartingHand {
+		h.Add(card)
+	}
+	p := player.NewPlayer(spec.Name, spec.Health, 0, h, d)
+	p.SetCardCost(effect.Cost)
+	return p
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"github.com/ShookieShookie/WorkshopImpl/collection"
+	"github.com/ShookieShookie/WorkshopImpl/draft"
+	"github.com/ShookieShookie/WorkshopImpl/effect"
+	"github.com/ShookieShookie/WorkshopImpl/hero"
+	"github.com/ShookieShookie/WorkshopImpl/secret"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// manageCollection shows a player's collection, "collection ID open|craft N|disenchant N|deck N,N,...|class NAME"
+// changes it
+func manageCollection(store *collection.FileStore, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("choose whose collection, e.g. collection player1")
+	}
+	book, err := store.Load()
+	if err != nil {
+		return err
+	}
+	c := book.Get(args[0], originalDeck)
+	catalog := draft.DefaultCatalog()
+	for _, card := range hero.ClassCards() {
+		catalog = append(catalog, draft.Card{Value: card, Rarity: draft.Epic})
+	}
+	for _, card := range secret.Values() {
+		catalog = append(catalog, draft.Card{Value: card, Rarity: draft.Rare})
+	}
+	for _, card := range effect.Values() {
+		catalog = append(catalog, draft.Card{Value: card, Rarity: draft.Rare})
+	}
+	if len(args) > 1 {
+		switch args[1] {
+		case "open":
+			rand.Seed(time.Now().UnixNano())
+			pack, err := c.Open(catalog, collection.DefaultPackConfig(), rand.Intn)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("You opened: %v\n", pack)
+		case "craft", "disenchant":
+			if len(args) < 3 {
+				return fmt.Errorf("choose a card to %s, e.g. %s 8", args[1], args[1])
+			}
+			card, err := strconv.Atoi(args[2])
+			if err != nil {
+				return fmt.Errorf("%q isn't a card", args[2])
+			}
+			if args[1] == "craft" {
+				err = c.Craft(catalog, card)
+			} else {
+				err = c.Disenchant(catalog, card)
+			}
+			if err != nil {
+				return err
+			}
+		case "deck":
+			if len(args) < 3 {
+				return fmt.Errorf("list the deck's cards, e.g. deck 0,1,1,2")
+			}
+			cards, err := parseCards(args[2])
+			if err != nil {
+				return err
+			}
+			if err := hero.CheckDeck(c.Class, cards); err != nil {
+				return err
+			}
+			if err := c.SetDeck(cards); err != nil {
+				return err
+			}
+		case "class":
+			if len(args) < 3 {
+				return fmt.Errorf("choose a class, one of: %s", strings.Join(hero.Names(), ", "))
+			}
+			if _, err := hero.Lookup(args[2]); err != nil {
+				return err
+			}
+			if err := hero.CheckDeck(args[2], c.Deck); err != nil {
+				return fmt.Errorf("change your deck first, %v", err)
+			}
+			c.Class = args[2]
+		default:
+			return fmt.Errorf("unknown collection command %q, use open, craft, disenchant, deck or class", args[1])
+		}
+	}
+	if err := store.Save(book); err != nil {
+		return err
+	}
+	owned := []int{}
+	for card := range c.Cards {
+		owned = append(owned, card)
+	}
+	sort.Ints(owned)
+	class := c.Class
+	if class == "" {
+		class = "no class"
+	}
+	fmt.Printf("%s (%s) has %d dust and %d packs\n", args[0], class, c.Dust, c.Packs)
+	for _, card := range owned {
+		fmt.Printf("  %d x%d\n", card, c.Cards[card])
+	}
+	fmt.Printf("Deck: %v\n", c.Deck)
+	return nil
+}
+
+// parseCards reads a deck list like 0,1,1,2
+func parseCards(list string) ([]int, error) {
+	cards := []int{}
+	for _, s := range strings.Split(list, ",") {
+		card, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("%q isn't a card", s)
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
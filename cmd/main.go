@@ -2,42 +2,311 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
+	"github.com/ShookieShookie/WorkshopImpl/campaign"
+	"github.com/ShookieShookie/WorkshopImpl/collection"
+	"github.com/ShookieShookie/WorkshopImpl/command"
 	"github.com/ShookieShookie/WorkshopImpl/deck"
+	"github.com/ShookieShookie/WorkshopImpl/effect"
 	"github.com/ShookieShookie/WorkshopImpl/game"
 	"github.com/ShookieShookie/WorkshopImpl/hand"
+	"github.com/ShookieShookie/WorkshopImpl/hero"
+	"github.com/ShookieShookie/WorkshopImpl/matchlog"
 	"github.com/ShookieShookie/WorkshopImpl/player"
+	"github.com/ShookieShookie/WorkshopImpl/rating"
+	"github.com/ShookieShookie/WorkshopImpl/secret"
+	"github.com/ShookieShookie/WorkshopImpl/tournament"
+	"github.com/ShookieShookie/WorkshopImpl/tui"
+	"io"
 	"math/rand"
 	"os"
+	"os/signal"
+	"strconv"
 	"time"
 )
 
 var originalDeck = []int{0, 0, 1, 1, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 5, 5, 6, 6, 7, 8}
 
+// stdin is shared so lines buffered by one read aren't lost to the next
+var stdin = bufio.NewScanner(os.Stdin)
+
 func main() {
+	ladderPath := flag.String("ladder", "ladder.json", "file the ladder ratings are stored in")
+	turnTime := flag.Duration("turn-time", 0, "time allowed per turn, 0 for no limit")
+	timeBank := flag.Duration("time-bank", 0, "reserve time each player can spend once a turn runs over")
+	rope := flag.Duration("rope", 15*time.Second, "warn a player when this much time is left")
+	grace := flag.Duration("disconnect-grace", 30*time.Second, "how long a disconnected player has to come back before forfeiting")
+	lineMode := flag.Bool("line", false, "use the plain line interface even on a terminal")
+	players := flag.Int("players", 2, "number of players")
+	mode := flag.String("mode", "ffa", "ffa for every player for themselves or teams for two teams taking turns")
+	handSize := flag.Int("hand-size", hand.DefaultSize, "most cards a player can hold, more drawn are burned")
+	deckSize := flag.Int("deck-size", 20, "cards drafted into an arena deck")
+	arenaWins := flag.Int("arena-wins", 7, "wins that complete an arena run")
+	arenaLosses := flag.Int("arena-losses", 3, "losses that end an arena run")
+	campaignPath := flag.String("campaign", "data/campaign.json", "file the campaign encounters are read from")
+	puzzlePath := flag.String("puzzles", "data/puzzles.json", "file the puzzles are read from")
+	tutorialPath := flag.String("tutorial", "data/tutorial.json", "file the tutorial script is read from")
+	rosterPath := flag.String("roster", "data/roster.json", "file the tournament entrants are read from")
+	resultsPath := flag.String("results", "tournament-results.json", "file tournament matches are saved to")
+	format := flag.String("format", "swiss", "tournament format, swiss, single or double elimination")
+	bestOf := flag.Int("best-of", 3, "games in each tournament match")
+	matchRules := flag.String("match-rules", "conquest", "conquest or lhs for last hero standing")
+	rounds := flag.Int("rounds", 0, "Swiss rounds, 0 for enough to leave one unbeaten entrant")
+	collectionPath := flag.String("collection", "collection.json", "file every player's cards are stored in")
+	undoTurns := flag.Bool("undo-turns", false, "let practice games undo past the start of the turn")
+	progressPath := flag.String("progress", "campaign-progress.json", "file campaign progress is stored in")
+	matchLogPath := flag.String("match-log", "", "file every match played is logged to, none if empty, jsonl logs are appended to")
+	matchLogFormat := flag.String("match-log-format", "text", "match log format, text, jsonl or markdown")
+	flag.Parse()
+	store := rating.NewFileStore(*ladderPath)
+	book := collection.NewFileStore(*collectionPath)
+	log := game.NewEventLog()
+	recorder := matchlog.NewRecorder()
+	rulesTurn := func(r game.Ruleset) game.TurnFunc {
+		return recorder.Wrap(game.NewClock(*turnTime, *timeBank, *rope, log).Wrap(command.Turn(game.NewRulesWith(log, r))))
+	}
+	ruleset := game.DefaultRuleset()
+	ruleset.Secrets = secret.Cards()
+	ruleset.Effects = effect.Cards(effect.DefaultPool(), rand.Intn)
+	ruleset.Cost = effect.Cost
+	turn := rulesTurn(ruleset)
+	start := func(turn game.TurnFunc, run func(frontend) error) error {
+		return withFrontend(*lineMode, *grace, turn, run)
+	}
+
+	var err error
+	switch flag.Arg(0) {
+	case "ladder":
+		err = ladder(store, flag.Args()[1:])
+	case "arena":
+		err = start(turn, func(f frontend) error {
+			return arena(f, *deckSize, *arenaWins, *arenaLosses)
+		})
+	case "analyze":
+		err = analyze(ruleset, flag.Args()[1:])
+	case "stats":
+		err = showStats(flag.Args()[1:])
+	case "collection":
+		err = manageCollection(book, flag.Args()[1:])
+	case "campaign":
+		err = playCampaign(*campaignPath, campaign.NewProgressStore(*progressPath), flag.Args()[1:], ruleset, rulesTurn, start)
+	case "puzzle":
+		err = puzzles(*puzzlePath, flag.Args()[1:], rulesTurn, start)
+	case "tutorial":
+		err = learn(*tutorialPath, turn, start)
+	case "practice":
+		// the full screen UI draws tables of live players, practice games are states
+		err = withFrontend(true, *grace, turn, func(f frontend) error {
+			return practice(f, book, ruleset, *handSize, *undoTurns)
+		})
+	case "tournament":
+		err = start(turn, func(f frontend) error {
+			return runTournament(f, *rosterPath, *resultsPath, tournament.Format(*format), *bestOf, tournament.MatchRules(*matchRules), *rounds)
+		})
+	default:
+		seating := table{players: *players, teams: *mode == "teams", handSize: *handSize}
+		if err = seating.validate(*mode); err != nil {
+			break
+		}
+		// with more than two sides a turn count would hand out mana a lap apart
+		r := ruleset
+		r.ManaByRound = seating.players > 2 || seating.teams
+		err = start(rulesTurn(r), func(f frontend) error {
+			return play(store, book, seating, f.input, f.turn)
+		})
+	}
+	if err == nil && *matchLogPath != "" {
+		err = saveMatchLog(*matchLogPath, matchlog.Format(*matchLogFormat), recorder.Entries())
+	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+// saveMatchLog appends to JSON lines logs so they build up over sessions, the other formats are
+// rewritten
+func saveMatchLog(path string, format matchlog.Format, entries []matchlog.Entry) error {
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if format == matchlog.JSONLines {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	}
+	f, err := os.OpenFile(path, flags, 0644)
+	if err != nil {
+		return err
+	}
+	if err := matchlog.Write(f, entries, format); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// table is who sits down to play, with teams players alternate between two sides
+type table struct {
+	players  int
+	teams    bool
+	handSize int
+}
+
+func (t table) validate(mode string) error {
+	if mode != "ffa" && mode != "teams" {
+		return fmt.Errorf("unknown mode %q, use ffa or teams", mode)
+	}
+	if t.players < 2 {
+		return fmt.Errorf("a game needs at least 2 players, got %d", t.players)
+	}
+	if t.teams && t.players%2 != 0 {
+		return fmt.Errorf("teams need an even number of players, got %d", t.players)
+	}
+	if t.handSize < 1 {
+		return fmt.Errorf("a hand has to hold at least 1 card, got %d", t.handSize)
+	}
+	return nil
+}
+
+// newGame deals every player the deck from their collection
+func (t table) newGame(book *collection.Book, input func() string, turn game.TurnFunc) (*game.Game, error) {
+	players := make([]game.Player, t.players)
+	for i := range players {
+		id := fmt.Sprintf("player%d", i+1)
+		c := book.Get(id, originalDeck)
+		d, err := deck.Build(c.Deck, c, rand.Intn)
+		if err == nil {
+			err = hero.CheckDeck(c.Class, c.Deck)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s can't play: %v", id, err)
+		}
+		p := player.NewPlayer(id, 30, 0, hand.NewSizedHand(t.handSize), d)
+		p.SetCardCost(effect.Cost)
+		players[i] = p
+		if c.Class != "" {
+			class, err := hero.Lookup(c.Class)
+			if err != nil {
+				return nil, err
+			}
+			players[i] = hero.New(p, class)
+		}
+	}
+	if !t.teams {
+		return game.NewFreeForAll(players, input, turn), nil
+	}
+	teams := [][]game.Player{{}, {}}
+	for i, p := range players {
+		teams[i%2] = append(teams[i%2], p)
+	}
+	return game.NewTeamGame(teams, input, turn), nil
+}
+
+// frontend is how players see the game and give input, either the full screen UI or plain lines
+type frontend struct {
+	input func() string
+	// choose reads a choice outside of a game, like a draft pick
+	choose func() string
+	turn   game.TurnFunc
+	out    io.Writer
+}
+
+// withFrontend runs in the full screen UI on a terminal unless lineMode is set
+func withFrontend(lineMode bool, grace time.Duration, turn game.TurnFunc, run func(frontend) error) error {
+	if lineMode || !tui.IsTerminal(os.Stdin) || !tui.IsTerminal(os.Stdout) {
+		return run(frontend{
+			input:  game.WithGrace(prompt("Enter a command, e.g. play 0 or end (help lists them all): "), grace, time.Second),
+			choose: prompt("Choose: "),
+			turn:   turn,
+			out:    os.Stdout,
+		})
+	}
+	restore, err := tui.Raw()
+	if err != nil {
+		return err
+	}
+	defer restore()
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	go func() {
+		<-interrupted
+		restore()
+		os.Exit(130)
+	}()
+	screen := tui.NewScreen(os.Stdin, os.Stdout)
+	game.SetOutput(screen)
+	return run(frontend{
+		input:  game.WithGrace(screen.Input, grace, time.Second),
+		choose: screen.Input,
+		turn:   screen.Wrap(turn),
+		out:    screen,
+	})
+}
+
+// play rates the game on the ladder and gives every winner a pack
+func play(store *rating.FileStore, books *collection.FileStore, seating table, input func() string, turn game.TurnFunc) error {
 	rand.Seed(int64(time.Now().Second()))
-	h1 := hand.NewHand()
-	d1 := deck.NewDeck(rand.Intn)
-	for i := 0; i < 20; i++ {
-		d1.Add(originalDeck[i])
-	}
-	p1 := player.NewPlayer("player1", 30, 0, h1, d1)
-	h2 := hand.NewHand()
-	d2 := deck.NewDeck(rand.Intn)
-	for i := 0; i < 20; i++ {
-		d2.Add(originalDeck[i])
-	}
-	p2 := player.NewPlayer("player2", 30, 0, h2, d2)
-
-	g := game.NewGame(p1, p2, getCard, game.Turn)
-	g.Start()
-}
-
-// requires an integration test
-func getCard() string {
-	fmt.Printf("Enter card index to play (-1 to end turn): ")
-	defer fmt.Printf("\n")
-	scanner := bufio.NewScanner(os.Stdin)
-	scanner.Scan()
-	return scanner.Text()
+	book, err := books.Load()
+	if err != nil {
+		return err
+	}
+	g, err := seating.newGame(book, input, turn)
+	if err != nil {
+		return err
+	}
+	result := g.Start()
+	if result == nil {
+		return nil
+	}
+	l, err := store.Load()
+	if err != nil {
+		return err
+	}
+	l.RecordResult(result.Winners, result.Losers, result.Draw)
+	if err := store.Save(l); err != nil {
+		return err
+	}
+	if !result.Draw {
+		for _, id := range result.Winners {
+			book.Get(id, originalDeck).Packs++
+		}
+	}
+	return books.Save(book)
+}
+
+// ladder prints the leaderboard, "ladder season [factor]" starts a new season with a soft reset
+func ladder(store *rating.FileStore, args []string) error {
+	l, err := store.Load()
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 && args[0] == "season" {
+		factor := 0.5
+		if len(args) > 1 {
+			factor, err = strconv.ParseFloat(args[1], 64)
+			if err != nil || factor < 0 || factor > 1 {
+				return fmt.Errorf("season reset factor must be between 0 and 1, got %q", args[1])
+			}
+		}
+		l.NewSeason(factor)
+		if err := store.Save(l); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("Season %d\n", l.Season)
+	fmt.Printf("%-4s %-16s %7s %5s %6s %4s %4s %4s\n", "#", "player", "rating", "RD", "vol", "W", "L", "D")
+	for i, e := range l.Leaderboard() {
+		fmt.Printf("%-4d %-16s %7.0f %5.0f %6.4f %4d %4d %4d\n", i+1, e.ID, e.Rating.Rating, e.Rating.Deviation, e.Rating.Volatility, e.Wins, e.Losses, e.Draws)
+	}
+	return nil
+}
+
+// prompt reads a line from stdin after showing text, requires an integration test
+func prompt(text string) func() string {
+	return func() string {
+		fmt.Print(text)
+		defer fmt.Printf("\n")
+		if !stdin.Scan() {
+			return game.Disconnected
+		}
+		return stdin.Text()
+	}
 }
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"github.com/ShookieShookie/WorkshopImpl/collection"
+	"github.com/ShookieShookie/WorkshopImpl/command"
+	"github.com/ShookieShookie/WorkshopImpl/deck"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/hero"
+	"io"
+	"math/rand"
+	"time"
+)
+
+// practice is a hotseat game between the collection decks of player1 and player2 where actions can be
+// undone and redone, it's played by the same ruleset as live games but isn't rated
+func practice(f frontend, books *collection.FileStore, ruleset game.Ruleset, handSize int, acrossTurns bool) error {
+	if handSize < 1 {
+		return fmt.Errorf("a hand has to hold at least 1 card, got %d", handSize)
+	}
+	book, err := books.Load()
+	if err != nil {
+		return err
+	}
+	rand.Seed(time.Now().UnixNano())
+	players := []game.PlayerState{}
+	for _, id := range []string{"player1", "player2"} {
+		p, err := practicePlayer(id, book.Get(id, originalDeck))
+		if err != nil {
+			return fmt.Errorf("%s can't practice: %v", id, err)
+		}
+		players = append(players, p)
+	}
+	rules := game.NewRulesWith(game.NewEventLog(), ruleset)
+	start, events, err := rules.NewGameState(players, nil, handSize)
+	if err != nil {
+		return err
+	}
+	h := game.NewHistory(rules, start, acrossTurns)
+	printEvents(f.out, events)
+	for h.State().Result == nil {
+		printState(f.out, h.State())
+		s := f.input()
+		if s == game.Disconnected {
+			return nil
+		}
+		cmd, err := command.Parse(s)
+		if err != nil {
+			fmt.Fprintln(f.out, err)
+			continue
+		}
+		switch cmd.Verb {
+		case command.Undo:
+			var a game.Action
+			if a, err = h.Undo(); err == nil {
+				fmt.Fprintln(f.out, "Undid", a)
+			}
+		case command.Redo:
+			var a game.Action
+			if a, events, err = h.Redo(); err == nil {
+				fmt.Fprintln(f.out, "Redid", a)
+				printEvents(f.out, events)
+			}
+		case command.Help:
+			fmt.Fprint(f.out, command.Usage())
+		case command.Log:
+			for _, a := range h.Actions() {
+				fmt.Fprintln(f.out, a)
+			}
+		default:
+			var a game.Action
+			if a, err = cmd.Action(h.State().ActivePlayer().ID); err == nil {
+				events, err = h.Apply(a)
+				printEvents(f.out, events)
+			}
+		}
+		if err != nil {
+			fmt.Fprintln(f.out, err)
+		}
+	}
+	fmt.Fprintln(f.out, h.State().Result.Winners, "win by", h.State().Result.Reason)
+	return nil
+}
+
+// practicePlayer shuffles the player's collection deck
+func practicePlayer(id string, c *collection.Collection) (game.PlayerState, error) {
+	if err := hero.CheckDeck(c.Class, c.Deck); err != nil {
+		return game.PlayerState{}, err
+	}
+	d, err := deck.Build(c.Deck, c, rand.Intn)
+	if err != nil {
+		return game.PlayerState{}, err
+	}
+	p := game.PlayerState{ID: id, Health: 30, Deck: d.Peek(d.Len())}
+	if c.Class != "" {
+		class, err := hero.Lookup(c.Class)
+		if err != nil {
+			return game.PlayerState{}, err
+		}
+		p.Power = class.Power.State()
+	}
+	return p, nil
+}
+
+func printEvents(out io.Writer, events []game.Event) {
+	for _, e := range events {
+		fmt.Fprintln(out, e)
+	}
+}
+
+func printState(out io.Writer, s game.GameState) {
+	for _, p := range s.Players {
+		fmt.Fprintf(out, "%s health: %d armor: %d cards left: %d", p.ID, p.Health, p.Armor, len(p.Deck))
+		if n := len(p.Secrets); n > 0 {
+			fmt.Fprintf(out, " (%d secret)", n)
+		}
+		fmt.Fprintln(out)
+	}
+	p := s.ActivePlayer()
+	fmt.Fprintf(out, "%s's turn, mana %d, hand %v\n", p.ID, p.Mana, p.Hand)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/puzzle"
+	"strconv"
+)
+
+// puzzles lists the puzzles, "puzzle N" plays one and "puzzle solve" prints a verified solution for each
+func puzzles(path string, args []string, rulesTurn func(game.Ruleset) game.TurnFunc, start func(game.TurnFunc, func(frontend) error) error) error {
+	all, err := puzzle.Load(path)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		for i, p := range all {
+			fmt.Printf("%d. %s (%s)\n", i+1, p.Name, p.Goal)
+		}
+		return nil
+	}
+	if args[0] == "solve" {
+		for i, p := range all {
+			s, err := puzzle.Solve(p)
+			if err == nil {
+				err = p.Verify(s)
+			}
+			if err != nil {
+				return fmt.Errorf("%d. %s: %v", i+1, p.Name, err)
+			}
+			fmt.Printf("%d. %s: play %v then end\n", i+1, p.Name, s.Plays)
+		}
+		return nil
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 1 || n > len(all) {
+		return fmt.Errorf("choose a puzzle from 1 to %d, got %q", len(all), args[0])
+	}
+	p := all[n-1]
+	return start(rulesTurn(p.Ruleset()), func(f frontend) error {
+		fmt.Fprintf(f.out, "%s: %s in one turn\n", p.Name, p.Goal)
+		if p.Play(f.turn, f.input) {
+			fmt.Fprintln(f.out, "Puzzle solved!")
+		} else {
+			fmt.Fprintln(f.out, "Not this time, try again")
+		}
+		return nil
+	})
+}
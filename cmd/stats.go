@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ShookieShookie/WorkshopImpl/matchlog"
+	"github.com/ShookieShookie/WorkshopImpl/stats"
+)
+
+// showStats prints how every card and deck did in the matches logged to the files given, "stats FILE..."
+// reads logs written with -match-log-format jsonl
+func showStats(paths []string) error {
+	if len(paths) == 0 {
+		return fmt.Errorf("choose match logs written with -match-log-format jsonl, e.g. stats matches.jsonl")
+	}
+	entries := []matchlog.Entry{}
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		read, err := matchlog.Read(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		entries = append(entries, read...)
+	}
+	r := stats.Aggregate(entries)
+	fmt.Printf("%d matches\n", r.Matches)
+	fmt.Printf("%-5s %6s %6s %6s %7s %7s %7s\n", "card", "drawn", "played", "play%", "win%", "curve%", "turn")
+	for _, c := range r.Cards {
+		fmt.Printf("%-5d %6d %6d %6.0f %7.0f %7.0f %7.1f\n", c.Card, c.Drawn, c.Played, 100*c.PlayRate(), 100*c.WinRateDrawn(), 100*c.WinRateOnCurve(), c.AverageTurn())
+	}
+	decks := r.Decks()
+	if len(decks) == 0 {
+		return nil
+	}
+	fmt.Printf("\n%-17s", "W-L-D vs")
+	for _, d := range decks {
+		fmt.Printf(" %-17s", d)
+	}
+	fmt.Println()
+	for _, d := range decks {
+		fmt.Printf("%-17s", d)
+		for _, o := range decks {
+			m := r.Matchups[d][o]
+			fmt.Printf(" %-17s", fmt.Sprintf("%d-%d-%d", m.Wins, m.Losses, m.Draws))
+		}
+		fmt.Println()
+	}
+	return nil
+}
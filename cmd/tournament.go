@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ShookieShookie/WorkshopImpl/campaign"
+	"github.com/ShookieShookie/WorkshopImpl/deck"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/hand"
+	"github.com/ShookieShookie/WorkshopImpl/player"
+	"github.com/ShookieShookie/WorkshopImpl/tournament"
+	"io/ioutil"
+	"math/rand"
+	"time"
+)
+
+// runTournament plays the roster through the format and saves every match to resultsPath
+func runTournament(f frontend, rosterPath, resultsPath string, format tournament.Format, bestOf int, rules tournament.MatchRules, rounds int) error {
+	entrants, err := tournament.LoadRoster(rosterPath)
+	if err != nil {
+		return err
+	}
+	t, err := tournament.NewTournament(entrants, format, bestOf, rules, rounds)
+	if err != nil {
+		return err
+	}
+	rand.Seed(time.Now().UnixNano())
+	if err := t.Run(tournamentGame(f), f.out); err != nil {
+		return err
+	}
+	if format == tournament.Swiss {
+		fmt.Fprintf(f.out, "%-4s %-16s %6s %5s %6s %6s\n", "#", "entrant", "points", "W-L", "OMW%", "GW%")
+		for i, s := range t.Standings() {
+			fmt.Fprintf(f.out, "%-4d %-16s %6d %2d-%-2d %6.1f %6.1f\n", i+1, s.Name, s.Points, s.MatchWins, s.MatchLosses, s.OpponentMatchWin*100, s.GameWin*100)
+		}
+	}
+	b, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(resultsPath, b, 0644)
+}
+
+// tournamentGame plays AI entrants with the aggressive boss AI and asks humans for input
+func tournamentGame(f frontend) tournament.PlayGame {
+	return func(first, second tournament.Entrant, firstDeck, secondDeck []int) *game.Result {
+		fmt.Fprintf(f.out, "%s vs %s\n", first.Name, second.Name)
+		turn := f.turn
+		heroes := []*player.PlayerImpl{}
+		for _, s := range []struct {
+			e     tournament.Entrant
+			cards []int
+		}{{first, firstDeck}, {second, secondDeck}} {
+			d := deck.NewDeck(rand.Intn)
+			for _, c := range s.cards {
+				d.Add(c)
+			}
+			hero := player.NewPlayer(s.e.Name, 30, 0, hand.NewHand(), d)
+			if s.e.AI {
+				turn = campaign.NewBoss(hero, campaign.Aggressive, nil).Wrap(turn)
+			}
+			heroes = append(heroes, hero)
+		}
+		return game.NewGame(heroes[0], heroes[1], f.input, turn).Start()
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/tutorial"
+)
+
+// learn plays the tutorial script against its scripted opponent
+func learn(path string, turn game.TurnFunc, start func(game.TurnFunc, func(frontend) error) error) error {
+	script, err := tutorial.Load(path)
+	if err != nil {
+		return err
+	}
+	t := tutorial.NewTutorial(script)
+	return start(t.Wrap(turn), func(f frontend) error {
+		result := t.NewGame(f.input, f.turn).Start()
+		if result != nil && t.Done() && len(result.Winners) == 1 && result.Winners[0] == tutorial.PlayerID {
+			fmt.Fprintln(f.out, "Tutorial complete, run without a subcommand to play a real game")
+		}
+		return nil
+	})
+}
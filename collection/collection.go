@@ -0,0 +1,74 @@
+package collection
+
+import (
+	"github.com/ShookieShookie/WorkshopImpl/deck"
+	"github.com/ShookieShookie/WorkshopImpl/draft"
+)
+
+// starterPacks is how many packs a new player gets to open
+const starterPacks = 3
+
+// Collection is the cards one player owns and what they have to spend
+type Collection struct {
+	Cards map[int]int `json:"cards"`
+	Dust  int         `json:"dust"`
+	Packs int         `json:"packs"`
+	// Deck is what they play with, it only ever holds cards they own
+	Deck []int `json:"deck"`
+	// Class is the hero class they play as, empty for none
+	Class string `json:"class"`
+	// PacksSince counts the packs opened since each pity rarity last showed up
+	PacksSince map[draft.Rarity]int `json:"packsSince"`
+}
+
+// NewCollection starts a player off owning the starter cards as their deck
+func NewCollection(starter []int) *Collection {
+	c := &Collection{
+		Cards:      map[int]int{},
+		Packs:      starterPacks,
+		Deck:       append([]int{}, starter...),
+		PacksSince: map[draft.Rarity]int{},
+	}
+	for _, card := range starter {
+		c.Add(card)
+	}
+	return c
+}
+
+func (c *Collection) Owned(card int) int {
+	return c.Cards[card]
+}
+
+func (c *Collection) Add(card int) {
+	c.Cards[card]++
+}
+
+// SetDeck replaces the deck, as long as every card in it is owned
+func (c *Collection) SetDeck(cards []int) error {
+	if err := deck.Check(cards, c); err != nil {
+		return err
+	}
+	c.Deck = append([]int{}, cards...)
+	return nil
+}
+
+// Book is every player's collection
+type Book struct {
+	Players map[string]*Collection `json:"players"`
+}
+
+func NewBook() *Book {
+	return &Book{
+		Players: map[string]*Collection{},
+	}
+}
+
+// Get returns a player's collection, starting a new one with the starter cards the first time
+func (b *Book) Get(id string, starter []int) *Collection {
+	c, ok := b.Players[id]
+	if !ok {
+		c = NewCollection(starter)
+		b.Players[id] = c
+	}
+	return c
+}
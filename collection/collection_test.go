@@ -0,0 +1,51 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/draft"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCollection(t *testing.T) {
+	c := NewCollection([]int{1, 1, 2})
+	assert.Equal(t, &Collection{
+		Cards:      map[int]int{1: 2, 2: 1},
+		Packs:      starterPacks,
+		Deck:       []int{1, 1, 2},
+		PacksSince: map[draft.Rarity]int{},
+	}, c)
+}
+
+func TestCollection_SetDeck(t *testing.T) {
+	tests := []struct {
+		name     string
+		cards    []int
+		wantDeck []int
+		wantErr  string
+	}{
+		{name: "owned", cards: []int{2, 1}, wantDeck: []int{2, 1}},
+		{name: "not owned", cards: []int{2, 2}, wantDeck: []int{1, 1, 2}, wantErr: "the deck needs 2 copies of 2 but only 1 are owned"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCollection([]int{1, 1, 2})
+			err := c.SetDeck(tt.cards)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantDeck, c.Deck)
+		})
+	}
+}
+
+func TestBook_Get(t *testing.T) {
+	b := NewBook()
+	c := b.Get("p1", []int{1})
+	c.Add(5)
+	assert.True(t, c == b.Get("p1", []int{1}))
+	assert.Equal(t, 1, b.Get("p1", nil).Owned(5))
+	assert.Equal(t, 0, b.Get("p2", []int{1}).Owned(5))
+}
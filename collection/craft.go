@@ -0,0 +1,67 @@
+package collection
+
+import (
+	"fmt"
+
+	"github.com/ShookieShookie/WorkshopImpl/draft"
+)
+
+var craftCost = map[draft.Rarity]int{
+	draft.Common:    40,
+	draft.Rare:      100,
+	draft.Epic:      400,
+	draft.Legendary: 1600,
+}
+
+var disenchantValue = map[draft.Rarity]int{
+	draft.Common:    5,
+	draft.Rare:      20,
+	draft.Epic:      100,
+	draft.Legendary: 400,
+}
+
+func rarityOf(catalog []draft.Card, card int) (draft.Rarity, error) {
+	for _, c := range catalog {
+		if c.Value == card {
+			return c.Rarity, nil
+		}
+	}
+	return "", fmt.Errorf("%d isn't a card that can be crafted", card)
+}
+
+// Craft spends dust on a copy of card
+func (c *Collection) Craft(catalog []draft.Card, card int) error {
+	r, err := rarityOf(catalog, card)
+	if err != nil {
+		return err
+	}
+	if c.Dust < craftCost[r] {
+		return fmt.Errorf("crafting a %s costs %d dust, you have %d", r, craftCost[r], c.Dust)
+	}
+	c.Dust -= craftCost[r]
+	c.Add(card)
+	return nil
+}
+
+// Disenchant turns a copy of card into dust, copies the deck needs are kept
+func (c *Collection) Disenchant(catalog []draft.Card, card int) error {
+	r, err := rarityOf(catalog, card)
+	if err != nil {
+		return err
+	}
+	inDeck := 0
+	for _, d := range c.Deck {
+		if d == card {
+			inDeck++
+		}
+	}
+	if c.Owned(card) <= inDeck {
+		return fmt.Errorf("you own %d copies of %d and your deck uses %d", c.Owned(card), card, inDeck)
+	}
+	c.Cards[card]--
+	if c.Cards[card] == 0 {
+		delete(c.Cards, card)
+	}
+	c.Dust += disenchantValue[r]
+	return nil
+}
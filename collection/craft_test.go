@@ -0,0 +1,67 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollection_Craft(t *testing.T) {
+	tests := []struct {
+		name     string
+		dust     int
+		card     int
+		wantDust int
+		wantErr  string
+	}{
+		{name: "common", dust: 50, card: 1, wantDust: 10},
+		{name: "legendary", dust: 1600, card: 8, wantDust: 0},
+		{name: "too poor", dust: 399, card: 7, wantDust: 399, wantErr: "crafting a epic costs 400 dust, you have 399"},
+		{name: "unknown card", dust: 5000, card: 9, wantDust: 5000, wantErr: "9 isn't a card that can be crafted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCollection(nil)
+			c.Dust = tt.dust
+			err := c.Craft(catalog, tt.card)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Equal(t, 0, c.Owned(tt.card))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, c.Owned(tt.card))
+			}
+			assert.Equal(t, tt.wantDust, c.Dust)
+		})
+	}
+}
+
+func TestCollection_Disenchant(t *testing.T) {
+	tests := []struct {
+		name      string
+		card      int
+		wantOwned int
+		wantDust  int
+		wantErr   string
+	}{
+		{name: "spare copy", card: 5, wantOwned: 1, wantDust: 20},
+		{name: "last copy outside the deck", card: 8, wantOwned: 0, wantDust: 400},
+		{name: "deck needs it", card: 1, wantOwned: 1, wantErr: "you own 1 copies of 1 and your deck uses 1"},
+		{name: "not owned", card: 7, wantOwned: 0, wantErr: "you own 0 copies of 7 and your deck uses 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCollection([]int{1, 5})
+			c.Add(5)
+			c.Add(8)
+			err := c.Disenchant(catalog, tt.card)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOwned, c.Owned(tt.card))
+			assert.Equal(t, tt.wantDust, c.Dust)
+		})
+	}
+}
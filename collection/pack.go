@@ -0,0 +1,134 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ShookieShookie/WorkshopImpl/draft"
+)
+
+// rarities is rarest first, the order pity timers are checked in
+var rarities = []draft.Rarity{draft.Legendary, draft.Epic, draft.Rare, draft.Common}
+
+var errNoPacks = errors.New("no packs to open")
+var errNoOdds = errors.New("no card in the catalog has a rarity with odds of coming up")
+
+// PackConfig is what comes in a pack
+type PackConfig struct {
+	Size int
+	// Odds are the relative chances of each card in a pack being that rarity
+	Odds map[draft.Rarity]int
+	// Pity guarantees a rarity at least once in every that many packs
+	Pity map[draft.Rarity]int
+}
+
+func DefaultPackConfig() PackConfig {
+	return PackConfig{
+		Size: 5,
+		Odds: map[draft.Rarity]int{
+			draft.Common:    70,
+			draft.Rare:      22,
+			draft.Epic:      6,
+			draft.Legendary: 2,
+		},
+		Pity: map[draft.Rarity]int{
+			draft.Epic:      10,
+			draft.Legendary: 30,
+		},
+	}
+}
+
+// Open spends a pack and adds its cards to the collection, random returns an int in [0, n) like
+// rand.Intn
+func (c *Collection) Open(catalog []draft.Card, config PackConfig, random func(int) int) ([]draft.Card, error) {
+	if c.Packs <= 0 {
+		return nil, errNoPacks
+	}
+	byRarity := map[draft.Rarity][]draft.Card{}
+	total := 0
+	for _, card := range catalog {
+		byRarity[card.Rarity] = append(byRarity[card.Rarity], card)
+	}
+	for _, r := range rarities {
+		if config.Odds[r] < 0 {
+			return nil, fmt.Errorf("the odds of a %s card can't be negative, got %d", r, config.Odds[r])
+		}
+		if len(byRarity[r]) > 0 {
+			total += config.Odds[r]
+		}
+	}
+	if total == 0 {
+		return nil, errNoOdds
+	}
+	pack := make([]draft.Card, config.Size)
+	for i := range pack {
+		roll := random(total)
+		for _, r := range rarities {
+			if len(byRarity[r]) == 0 {
+				continue
+			}
+			if roll < config.Odds[r] {
+				pack[i] = byRarity[r][random(len(byRarity[r]))]
+				break
+			}
+			roll -= config.Odds[r]
+		}
+	}
+	// pity replaces the commonest card below the guaranteed rarity, so it never takes a card that was
+	// rolled at or above it
+	for _, r := range rarities {
+		pity, ok := config.Pity[r]
+		if !ok || len(byRarity[r]) == 0 || contains(pack, r) || c.PacksSince[r]+1 < pity {
+			continue
+		}
+		if slot := commonest(pack, r); slot >= 0 {
+			pack[slot] = byRarity[r][random(len(byRarity[r]))]
+		}
+	}
+	for _, r := range rarities {
+		if _, ok := config.Pity[r]; !ok || len(byRarity[r]) == 0 {
+			continue
+		}
+		if contains(pack, r) {
+			c.PacksSince[r] = 0
+		} else {
+			c.PacksSince[r]++
+		}
+	}
+	c.Packs--
+	for _, card := range pack {
+		c.Add(card.Value)
+	}
+	return pack, nil
+}
+
+// commonest is the index of the commonest card in the pack that's commoner than r, the last of them when
+// there are several, or -1 when every card is at least as rare as r
+func commonest(pack []draft.Card, r draft.Rarity) int {
+	slot := -1
+	for i, card := range pack {
+		if rarity(card.Rarity) > rarity(r) && (slot < 0 || rarity(card.Rarity) >= rarity(pack[slot].Rarity)) {
+			slot = i
+		}
+	}
+	return slot
+}
+
+// rarity ranks r by its place in rarities, commoner is higher
+func rarity(r draft.Rarity) int {
+	for i, o := range rarities {
+		if o == r {
+			return i
+		}
+	}
+	return len(rarities)
+}
+
+func contains(pack []draft.Card, r draft.Rarity) bool {
+	for _, card := range pack {
+		if card.Rarity == r {
+			return true
+		}
+	}
+	return false
+}
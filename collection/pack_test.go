@@ -0,0 +1,155 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/draft"
+	"github.com/stretchr/testify/assert"
+)
+
+var catalog = []draft.Card{
+	{Value: 1, Rarity: draft.Common},
+	{Value: 2, Rarity: draft.Common},
+	{Value: 5, Rarity: draft.Rare},
+	{Value: 7, Rarity: draft.Epic},
+	{Value: 8, Rarity: draft.Legendary},
+}
+
+// rolls returns each value in turn, rarity rolls are out of 100 with the default odds
+func rolls(values ...int) func(int) int {
+	return func(n int) int {
+		v := values[0]
+		values = values[1:]
+		return v % n
+	}
+}
+
+func TestCollection_Open(t *testing.T) {
+	config := PackConfig{
+		Size: 3,
+		Odds: DefaultPackConfig().Odds,
+		Pity: map[draft.Rarity]int{draft.Legendary: 2},
+	}
+	tests := []struct {
+		name      string
+		since     int
+		random    func(int) int
+		want      []draft.Card
+		wantSince int
+	}{
+		{
+			// legendary takes rolls 0-1, epic 2-7, rare 8-29 and common the rest
+			name:      "rolled rarities",
+			random:    rolls(0, 0, 5, 0, 50, 1),
+			want:      []draft.Card{catalog[4], catalog[3], catalog[1]},
+			wantSince: 0,
+		},
+		{
+			name:      "no legendary yet",
+			random:    rolls(50, 0, 50, 0, 50, 0),
+			want:      []draft.Card{catalog[0], catalog[0], catalog[0]},
+			wantSince: 1,
+		},
+		{
+			name:      "pity replaces the last card",
+			since:     1,
+			random:    rolls(50, 0, 50, 0, 50, 0, 0),
+			want:      []draft.Card{catalog[0], catalog[0], catalog[4]},
+			wantSince: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCollection(nil)
+			c.PacksSince[draft.Legendary] = tt.since
+
+			got, err := c.Open(catalog, config, tt.random)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantSince, c.PacksSince[draft.Legendary])
+			assert.Equal(t, starterPacks-1, c.Packs)
+			for _, card := range got {
+				assert.NotZero(t, c.Owned(card.Value))
+			}
+		})
+	}
+}
+
+func TestCollection_OpenPity(t *testing.T) {
+	config := PackConfig{
+		Size: 3,
+		Odds: DefaultPackConfig().Odds,
+		Pity: map[draft.Rarity]int{draft.Legendary: 30, draft.Epic: 2},
+	}
+	tests := []struct {
+		name          string
+		random        func(int) int
+		want          []draft.Card
+		wantEpic      int
+		wantLegendary int
+	}{
+		{
+			name:          "replaces the commonest card",
+			random:        rolls(50, 0, 10, 0, 50, 1, 0),
+			want:          []draft.Card{catalog[0], catalog[2], catalog[3]},
+			wantEpic:      0,
+			wantLegendary: 6,
+		},
+		{
+			name:     "keeps a rolled legendary",
+			random:   rolls(0, 0, 10, 0, 10, 0, 0),
+			want:     []draft.Card{catalog[4], catalog[2], catalog[3]},
+			wantEpic: 0,
+		},
+		{
+			name:     "nothing commoner to replace",
+			random:   rolls(0, 0, 0, 0, 0, 0),
+			want:     []draft.Card{catalog[4], catalog[4], catalog[4]},
+			wantEpic: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCollection(nil)
+			c.PacksSince[draft.Epic] = 1
+			c.PacksSince[draft.Legendary] = 5
+
+			got, err := c.Open(catalog, config, tt.random)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantEpic, c.PacksSince[draft.Epic])
+			assert.Equal(t, tt.wantLegendary, c.PacksSince[draft.Legendary])
+		})
+	}
+}
+
+func TestCollection_OpenNoPacks(t *testing.T) {
+	c := NewCollection(nil)
+	c.Packs = 0
+	_, err := c.Open(catalog, DefaultPackConfig(), nil)
+	assert.Equal(t, errNoPacks, err)
+}
+
+func TestCollection_OpenBadOdds(t *testing.T) {
+	tests := []struct {
+		name    string
+		catalog []draft.Card
+		odds    map[draft.Rarity]int
+		wantErr string
+	}{
+		{name: "empty catalog", odds: DefaultPackConfig().Odds, wantErr: errNoOdds.Error()},
+		{name: "no odds", catalog: catalog, odds: map[draft.Rarity]int{}, wantErr: errNoOdds.Error()},
+		{name: "negative odds", catalog: catalog, odds: map[draft.Rarity]int{draft.Common: 5, draft.Rare: -1}, wantErr: "the odds of a rare card can't be negative, got -1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCollection(nil)
+			c.Packs = 1
+			_, err := c.Open(tt.catalog, PackConfig{Size: 5, Odds: tt.odds}, func(int) int { return 0 })
+			assert.EqualError(t, err, tt.wantErr)
+			assert.Equal(t, 1, c.Packs, "the pack isn't spent")
+		})
+	}
+}
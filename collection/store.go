@@ -0,0 +1,41 @@
+package collection
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+)
+
+type FileStore struct {
+	path string
+}
+
+func NewFileStore(path string) *FileStore {
+	return &FileStore{
+		path: path,
+	}
+}
+
+// Load returns an empty book when the file doesn't exist yet
+func (s *FileStore) Load() (*Book, error) {
+	b, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return NewBook(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	book := NewBook()
+	if err := json.Unmarshal(b, book); err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+func (s *FileStore) Save(book *Book) error {
+	b, err := json.MarshalIndent(book, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(s.path, b, 0644)
+}
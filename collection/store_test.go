@@ -0,0 +1,32 @@
+package collection
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/draft"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "collection")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s := NewFileStore(filepath.Join(dir, "collection.json"))
+
+	b, err := s.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, NewBook(), b)
+
+	c := b.Get("p1", []int{0, 8})
+	c.Dust = 45
+	c.PacksSince[draft.Legendary] = 3
+	assert.NoError(t, s.Save(b))
+	got, err := s.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, b, got)
+}
@@ -0,0 +1,289 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+)
+
+type Verb string
+
+const (
+	Play    Verb = "play"
+	Power   Verb = "power"
+	Attack  Verb = "attack"
+	End     Verb = "end"
+	Concede Verb = "concede"
+	Draw    Verb = "draw"
+	Accept  Verb = "accept"
+	Decline Verb = "decline"
+	Hand    Verb = "hand"
+	Log     Verb = "log"
+	Help    Verb = "help"
+	Undo    Verb = "undo"
+	Redo    Verb = "redo"
+)
+
+// Enemy is the target for the opposing hero
+const Enemy = "enemy"
+
+// Command is a single parsed line of player input, Card is -1 when the verb takes none
+type Command struct {
+	Verb   Verb
+	Card   int
+	Target string
+}
+
+var verbs = []Verb{Play, Attack, End, Power, Concede, Draw, Accept, Decline, Hand, Log, Help, Undo, Redo}
+
+var usage = map[Verb]string{
+	Play:    "play <card> [at <target>]  play a card from your hand",
+	Power:   "power [at <target>]        use your hero power, once a turn",
+	Attack:  "attack <minion> <target>   attack with a minion on the board",
+	End:     "end                        end your turn",
+	Concede: "concede                    give up the game",
+	Draw:    "draw                       offer your opponent a draw",
+	Accept:  "accept                     accept a draw offer",
+	Decline: "decline                    decline a draw offer",
+	Hand:    "hand                       show your hand",
+	Log:     "log                        show what has happened so far",
+	Help:    "help                       show this list",
+	Undo:    "undo                       take back your last action, practice games only",
+	Redo:    "redo                       play an undone action again, practice games only",
+}
+
+var aliases = map[string]Verb{
+	"p":         Play,
+	"cast":      Play,
+	"hp":        Power,
+	"a":         Attack,
+	"atk":       Attack,
+	"e":         End,
+	"pass":      End,
+	"done":      End,
+	"ff":        Concede,
+	"surrender": Concede,
+	"yes":       Accept,
+	"y":         Accept,
+	"no":        Decline,
+	"n":         Decline,
+	"h":         Help,
+	"?":         Help,
+	"cards":     Hand,
+	"history":   Log,
+	"u":         Undo,
+	"back":      Undo,
+}
+
+var targetAliases = map[string]string{
+	Enemy:      Enemy,
+	"face":     Enemy,
+	"opponent": Enemy,
+	"hero":     Enemy,
+}
+
+// Parse understands verbs and their aliases, a bare card index is shorthand for play and -1 for end
+func Parse(line string) (Command, error) {
+	words := strings.Fields(strings.ToLower(line))
+	if len(words) == 0 {
+		return Command{}, fmt.Errorf("type a command, one of: %s", verbList())
+	}
+	if i, err := strconv.Atoi(words[0]); err == nil && len(words) == 1 {
+		if i == -1 {
+			return Command{Verb: End, Card: -1}, nil
+		}
+		return parsePlay(words)
+	}
+	if len(words) > 1 && words[0] == "hero" && words[1] == "power" {
+		words = words[1:]
+	}
+	verb, ok := lookupVerb(words[0])
+	if !ok {
+		return Command{}, fmt.Errorf("unknown command %q, try one of: %s", words[0], verbList())
+	}
+	args := words[1:]
+	switch verb {
+	case Play:
+		return parsePlay(args)
+	case Attack:
+		return parseAttack(args)
+	case Power:
+		return parsePower(args)
+	}
+	if len(args) > 0 {
+		return Command{}, fmt.Errorf("%s doesn't take anything after it, usage: %s", verb, usage[verb])
+	}
+	return Command{Verb: verb, Card: -1}, nil
+}
+
+// Action is what the command asks the rules to do for player, the enemy alias leaves the target for the
+// rules to pick. Commands that don't change the game aren't actions.
+func (c Command) Action(player string) (game.Action, error) {
+	target := c.Target
+	if target == Enemy {
+		target = ""
+	}
+	switch c.Verb {
+	case Play:
+		return game.PlayCard(player, c.Card, target), nil
+	case Attack:
+		return game.Attack(player, c.Card, target), nil
+	case Power:
+		return game.HeroPower(player, target), nil
+	case End:
+		return game.EndTurn(player), nil
+	case Concede:
+		return game.Concede(player), nil
+	}
+	return game.Action{}, fmt.Errorf("%s isn't a game action", c.Verb)
+}
+
+func parsePlay(args []string) (Command, error) {
+	if len(args) == 0 {
+		return Command{}, fmt.Errorf("play needs a card index, usage: %s", usage[Play])
+	}
+	card, err := cardIndex(args[0])
+	if err != nil {
+		return Command{}, err
+	}
+	switch len(args) {
+	case 1:
+		return Command{Verb: Play, Card: card}, nil
+	case 3:
+		if args[1] != "at" {
+			break
+		}
+		target, err := parseTarget(args[2])
+		if err != nil {
+			return Command{}, err
+		}
+		return Command{Verb: Play, Card: card, Target: target}, nil
+	}
+	return Command{}, fmt.Errorf("couldn't understand %q, usage: %s", strings.Join(append([]string{string(Play)}, args...), " "), usage[Play])
+}
+
+func parsePower(args []string) (Command, error) {
+	switch {
+	case len(args) == 0:
+		return Command{Verb: Power, Card: -1}, nil
+	case len(args) == 2 && args[0] == "at":
+		target, err := parseTarget(args[1])
+		if err != nil {
+			return Command{}, err
+		}
+		return Command{Verb: Power, Card: -1, Target: target}, nil
+	}
+	return Command{}, fmt.Errorf("couldn't understand %q, usage: %s", strings.Join(append([]string{string(Power)}, args...), " "), usage[Power])
+}
+
+func parseAttack(args []string) (Command, error) {
+	if len(args) != 2 {
+		return Command{}, fmt.Errorf("attack needs a minion and a target, usage: %s", usage[Attack])
+	}
+	minion, err := cardIndex(args[0])
+	if err != nil {
+		return Command{}, err
+	}
+	target, err := parseTarget(args[1])
+	if err != nil {
+		return Command{}, err
+	}
+	return Command{Verb: Attack, Card: minion, Target: target}, nil
+}
+
+func cardIndex(s string) (int, error) {
+	i, err := strconv.Atoi(s)
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf("%q isn't a card index, use a number like 0, 1 or 2", s)
+	}
+	return i, nil
+}
+
+// parseTarget resolves target aliases, anything else is left for the game to check against player names
+func parseTarget(s string) (string, error) {
+	if t, ok := targetAliases[s]; ok {
+		return t, nil
+	}
+	if _, err := strconv.Atoi(s); err == nil {
+		return "", fmt.Errorf("unknown target %q, valid targets are: %s", s, strings.Join(targets(), ", "))
+	}
+	return s, nil
+}
+
+func lookupVerb(word string) (Verb, bool) {
+	for _, v := range verbs {
+		if string(v) == word {
+			return v, true
+		}
+	}
+	v, ok := aliases[word]
+	return v, ok
+}
+
+func verbList() string {
+	names := make([]string, len(verbs))
+	for i, v := range verbs {
+		names[i] = string(v)
+	}
+	return strings.Join(names, ", ")
+}
+
+func targets() []string {
+	names := []string{}
+	for alias := range targetAliases {
+		names = append(names, alias)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Usage describes every command
+func Usage() string {
+	b := &strings.Builder{}
+	for _, v := range verbs {
+		fmt.Fprintf(b, "  %s\n", usage[v])
+	}
+	b.WriteString("  a bare number plays that card, -1 ends the turn\n")
+	return b.String()
+}
+
+// Complete returns the possible completions of a partly typed line
+func Complete(line string) []string {
+	words := strings.Fields(line)
+	if len(words) == 0 || strings.HasSuffix(line, " ") {
+		words = append(words, "")
+	}
+	prefix := strings.Join(words[:len(words)-1], " ")
+	if prefix != "" {
+		prefix += " "
+	}
+	partial := words[len(words)-1]
+	var options []string
+	switch len(words) {
+	case 1:
+		for _, v := range verbs {
+			options = append(options, string(v)+" ")
+		}
+	default:
+		verb, _ := lookupVerb(words[0])
+		switch {
+		case verb == Play && len(words) == 3,
+			verb == Power && len(words) == 2:
+			options = []string{"at "}
+		case verb == Play && len(words) == 4 && words[2] == "at",
+			verb == Power && len(words) == 3 && words[1] == "at",
+			verb == Attack && len(words) == 3:
+			options = targets()
+		}
+	}
+	completions := []string{}
+	for _, o := range options {
+		if strings.HasPrefix(o, partial) {
+			completions = append(completions, prefix+o)
+		}
+	}
+	return completions
+}
@@ -0,0 +1,117 @@
+package command
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    Command
+		wantErr string
+	}{
+		{name: "bare index plays", line: "2", want: Command{Verb: Play, Card: 2}},
+		{name: "minus one ends", line: "-1", want: Command{Verb: End, Card: -1}},
+		{name: "play", line: "play 2", want: Command{Verb: Play, Card: 2}},
+		{name: "play at enemy", line: "play 2 at enemy", want: Command{Verb: Play, Card: 2, Target: Enemy}},
+		{name: "target alias", line: "p 0 at face", want: Command{Verb: Play, Card: 0, Target: Enemy}},
+		{name: "target by name", line: "play 1 at player2", want: Command{Verb: Play, Card: 1, Target: "player2"}},
+		{name: "attack", line: "attack 1 face", want: Command{Verb: Attack, Card: 1, Target: Enemy}},
+		{name: "case and spacing", line: "  END  ", want: Command{Verb: End, Card: -1}},
+		{name: "alias", line: "ff", want: Command{Verb: Concede, Card: -1}},
+		{name: "yes accepts", line: "yes", want: Command{Verb: Accept, Card: -1}},
+		{name: "help", line: "?", want: Command{Verb: Help, Card: -1}},
+		{name: "undo alias", line: "u", want: Command{Verb: Undo, Card: -1}},
+		{name: "redo", line: "redo", want: Command{Verb: Redo, Card: -1}},
+		{name: "power", line: "power", want: Command{Verb: Power, Card: -1}},
+		{name: "hero power at", line: "hero power at face", want: Command{Verb: Power, Card: -1, Target: Enemy}},
+		{name: "power alias at name", line: "hp at player2", want: Command{Verb: Power, Card: -1, Target: "player2"}},
+		{name: "power missing at", line: "power enemy", wantErr: `couldn't understand "power enemy"`},
+		{name: "empty", line: "", wantErr: "type a command, one of: play, attack"},
+		{name: "typo", line: "plya 2", wantErr: `unknown command "plya", try one of: play, attack, end`},
+		{name: "play without a card", line: "play", wantErr: "play needs a card index"},
+		{name: "play a word", line: "play fireball", wantErr: `"fireball" isn't a card index`},
+		{name: "negative card", line: "play -2", wantErr: `"-2" isn't a card index`},
+		{name: "bare negative card", line: "-2", wantErr: `"-2" isn't a card index`},
+		{name: "play missing at", line: "play 2 enemy", wantErr: `couldn't understand "play 2 enemy"`},
+		{name: "numeric target", line: "play 2 at 3", wantErr: `unknown target "3", valid targets are: enemy, face, hero, opponent`},
+		{name: "attack without target", line: "attack 1", wantErr: "attack needs a minion and a target"},
+		{name: "end with arguments", line: "end now", wantErr: "end doesn't take anything after it"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.line)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Parse() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want []string
+	}{
+		{name: "verb", line: "pl", want: []string{"play "}},
+		{name: "several verbs", line: "d", want: []string{"draw ", "decline "}},
+		{name: "at", line: "play 2 ", want: []string{"play 2 at "}},
+		{name: "target", line: "play 2 at f", want: []string{"play 2 at face"}},
+		{name: "attack target", line: "attack 1 e", want: []string{"attack 1 enemy"}},
+		{name: "nothing after end", line: "end ", want: []string{}},
+		{name: "unknown", line: "zz", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Complete(tt.line))
+		})
+	}
+}
+
+func TestUsage(t *testing.T) {
+	u := Usage()
+	for _, v := range verbs {
+		assert.Contains(t, u, string(v))
+	}
+}
+
+func TestCommand_Action(t *testing.T) {
+	tests := []struct {
+		line    string
+		want    game.Action
+		wantErr bool
+	}{
+		{line: "play 2 at face", want: game.PlayCard("p1", 2, "")},
+		{line: "play 1 at p2", want: game.PlayCard("p1", 1, "p2")},
+		{line: "hp", want: game.HeroPower("p1", "")},
+		{line: "end", want: game.EndTurn("p1")},
+		{line: "concede", want: game.Concede("p1")},
+		{line: "attack 0 face", want: game.Attack("p1", 0, "")},
+		{line: "help", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			cmd, err := Parse(tt.line)
+			assert.NoError(t, err)
+			got, err := cmd.Action("p1")
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+)
+
+// Turn plays turns by the rules from typed commands, the ones that aren't game actions are answered here
+func Turn(rules *game.Rules) game.TurnFunc {
+	return func(iter int, active game.Player, table *game.Table, getInput func() string) *game.Result {
+		return rules.Play(iter, active, table, func() game.Action {
+			out := game.Output()
+			for {
+				printHealth(rules, table)
+				active.PrintStats()
+				s := getInput()
+				if s == game.Disconnected {
+					return game.Disconnect(active.ID())
+				}
+				cmd, err := Parse(s)
+				if err != nil {
+					fmt.Fprintln(out, err)
+					continue
+				}
+				switch cmd.Verb {
+				case Draw:
+					if offerDraw(active, table.Opponents(active), getInput) {
+						return game.AgreeDraw(active.ID())
+					}
+					fmt.Fprintln(out, "Draw declined")
+				case Accept, Decline:
+					fmt.Fprintln(out, "There is no draw offer to answer")
+				case Hand:
+					active.PrintStats()
+				case Log:
+					for _, e := range rules.Events() {
+						fmt.Fprintln(out, e)
+					}
+				case Help:
+					fmt.Fprint(out, Usage())
+				case Undo, Redo:
+					fmt.Fprintln(out, "Only practice games can undo and redo")
+				default:
+					a, err := cmd.Action(active.ID())
+					if err != nil {
+						fmt.Fprintln(out, err)
+						continue
+					}
+					return a
+				}
+			}
+		})
+	}
+}
+
+// offerDraw asks every opponent in turn, it's agreed only if they all accept
+func offerDraw(active game.Player, opponents []game.Player, getInput func() string) bool {
+	for _, o := range opponents {
+		fmt.Fprintf(game.Output(), "%s offers a draw, %s type accept or decline\n", active.ID(), o.ID())
+		cmd, err := Parse(getInput())
+		if err != nil || cmd.Verb != Accept {
+			return false
+		}
+	}
+	return true
+}
+
+func printHealth(rules *game.Rules, table *game.Table) {
+	health := []interface{}{}
+	for _, p := range table.Alive() {
+		health = append(health, p.ID(), "health:", p.GetHealth())
+		if n := rules.Secrets(p); n > 0 {
+			health = append(health, fmt.Sprintf("(%d secret)", n))
+		}
+	}
+	fmt.Fprintln(game.Output(), health...)
+}
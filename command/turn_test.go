@@ -0,0 +1,371 @@
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPlayer struct {
+	mock.Mock
+}
+
+func (m *mockPlayer) ApplyDamage(i int) {
+	m.Called(i)
+}
+func (m *mockPlayer) GetHealth() int {
+	args := m.Called()
+	return args.Get(0).(int)
+}
+func (m *mockPlayer) SetMana(i int) {
+	m.Called(i)
+}
+func (m *mockPlayer) PlayCard(index int) (int, error) {
+	args := m.Called(index)
+	return args.Get(0).(int), args.Error(1)
+}
+func (m *mockPlayer) IsDead() bool {
+	args := m.Called()
+	return args.Bool(0)
+}
+func (m *mockPlayer) Draw() error {
+	args := m.Called()
+	return args.Error(0)
+}
+func (m *mockPlayer) ID() string {
+	args := m.Called()
+	return args.Get(0).(string)
+}
+func (m *mockPlayer) PrintStats() {
+	m.Called()
+}
+
+type mockUserInput struct {
+	called int
+	input  []string
+}
+
+func (u *mockUserInput) get() string {
+	ret := u.input[u.called]
+	u.called++
+	return ret
+}
+
+func TestTurn(t *testing.T) {
+	type ActiveSetManaArgs struct {
+		in int
+	}
+	type ActiveDrawArgs struct {
+		ret error
+	}
+	type ActiveApplyDamageArgs struct {
+		damage int
+	}
+	type ActivePrintStatsArgs struct {
+	}
+	type ActivePlayCardArgs struct {
+		index  []int
+		damage []int
+		err    []error
+	}
+	type ActiveIDArgs struct {
+		ret string
+	}
+	type ActiveGetHealthArgs struct {
+		health int
+	}
+	type ActiveIsDeadArgs struct {
+		ret []bool
+	}
+	type PassiveGetHealthArgs struct {
+		health int
+	}
+	type PassiveIDArgs struct {
+		ret string
+	}
+	type PassiveApplyDamageArgs struct {
+		damage int
+	}
+	type PassiveIsDeadArgs struct {
+		ret []bool
+	}
+
+	type args struct {
+		iter      int
+		userInput []string
+	}
+	tests := []struct {
+		name                   string
+		args                   args
+		ActiveSetManaArgs      *ActiveSetManaArgs
+		ActiveDrawArgs         *ActiveDrawArgs
+		ActiveApplyDamageArgs  *ActiveApplyDamageArgs
+		ActivePrintStatsArgs   *ActivePrintStatsArgs
+		ActivePlayCardArgs     *ActivePlayCardArgs
+		ActiveIDArgs           *ActiveIDArgs
+		ActiveGetHealthArgs    *ActiveGetHealthArgs
+		ActiveIsDeadArgs       *ActiveIsDeadArgs
+		PassiveGetHealthArgs   *PassiveGetHealthArgs
+		PassiveIDArgs          *PassiveIDArgs
+		PassiveApplyDamageArgs *PassiveApplyDamageArgs
+		PassiveIsDeadArgs      *PassiveIsDeadArgs
+		want                   *game.Result
+	}{
+		{
+			name:                   "round 1 game over",
+			args:                   args{iter: 1, userInput: []string{"0"}},
+			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:         &ActiveDrawArgs{ret: nil},
+			ActiveApplyDamageArgs:  nil,
+			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
+			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{0}, damage: []int{5}, err: []error{nil}},
+			ActiveIDArgs:           &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 1},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{false}},
+			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: -1},
+			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
+			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{true}},
+			want:                   &game.Result{Winners: []string{"name1"}, Losers: []string{"name2"}, Reason: game.ReasonKilled},
+		},
+		{
+			name:                   "player 1 is out of deck and receives damage",
+			args:                   args{iter: 1, userInput: []string{"0"}},
+			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:         &ActiveDrawArgs{ret: errs.ErrDeckEmpty},
+			ActiveApplyDamageArgs:  &ActiveApplyDamageArgs{damage: 1},
+			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
+			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{0}, damage: []int{5}, err: []error{nil}},
+			ActiveIDArgs:           &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 1},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{false, false}},
+			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: -1},
+			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
+			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{false, true}},
+			want:                   &game.Result{Winners: []string{"name1"}, Losers: []string{"name2"}, Reason: game.ReasonKilled},
+		},
+		{
+			name:                   "bad user input first try",
+			args:                   args{iter: 1, userInput: []string{"asdf", "0"}},
+			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:         &ActiveDrawArgs{ret: errs.ErrDeckEmpty},
+			ActiveApplyDamageArgs:  &ActiveApplyDamageArgs{damage: 1},
+			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
+			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{0}, damage: []int{5}, err: []error{nil}},
+			ActiveIDArgs:           &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 1},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{false, false}},
+			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: -1},
+			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
+			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{false, true}},
+			want:                   &game.Result{Winners: []string{"name1"}, Losers: []string{"name2"}, Reason: game.ReasonKilled},
+		},
+		{
+			name:                   "user doesn't want to play any more cards",
+			args:                   args{iter: 1, userInput: []string{"-1"}},
+			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:         &ActiveDrawArgs{ret: nil},
+			ActiveApplyDamageArgs:  nil,
+			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
+			ActivePlayCardArgs:     nil,
+			ActiveIDArgs:           &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 1},
+			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: -1},
+			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
+			PassiveApplyDamageArgs: nil,
+			PassiveIsDeadArgs:      nil,
+			want:                   nil,
+		},
+		{
+			name:                   "play card illegal index causes a second turn",
+			args:                   args{iter: 1, userInput: []string{"12435", "1"}},
+			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:         &ActiveDrawArgs{ret: nil},
+			ActiveApplyDamageArgs:  nil,
+			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
+			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{12435, 1}, damage: []int{0, 5}, err: []error{errors.New("illegal index"), nil}},
+			ActiveIDArgs:           &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 1},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{false}},
+			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: -1},
+			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
+			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{true}},
+			want:                   &game.Result{Winners: []string{"name1"}, Losers: []string{"name2"}, Reason: game.ReasonKilled},
+		},
+		{
+			name:                 "concede",
+			args:                 args{iter: 1, userInput: []string{"concede"}},
+			ActiveSetManaArgs:    &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:       &ActiveDrawArgs{ret: nil},
+			ActivePrintStatsArgs: &ActivePrintStatsArgs{},
+			ActiveIDArgs:         &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:  &ActiveGetHealthArgs{health: 1},
+			PassiveGetHealthArgs: &PassiveGetHealthArgs{health: 1},
+			PassiveIDArgs:        &PassiveIDArgs{ret: "name2"},
+			want:                 &game.Result{Winners: []string{"name2"}, Losers: []string{"name1"}, Reason: game.ReasonConcede},
+		},
+		{
+			name:                 "draw offer accepted",
+			args:                 args{iter: 1, userInput: []string{"draw", "yes"}},
+			ActiveSetManaArgs:    &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:       &ActiveDrawArgs{ret: nil},
+			ActivePrintStatsArgs: &ActivePrintStatsArgs{},
+			ActiveIDArgs:         &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:  &ActiveGetHealthArgs{health: 1},
+			PassiveGetHealthArgs: &PassiveGetHealthArgs{health: 1},
+			PassiveIDArgs:        &PassiveIDArgs{ret: "name2"},
+			want:                 &game.Result{Winners: []string{"name1", "name2"}, Losers: []string{}, Draw: true, Reason: game.ReasonDrawAgreed},
+		},
+		{
+			name:                 "draw offer declined",
+			args:                 args{iter: 1, userInput: []string{"draw", "no", "-1"}},
+			ActiveSetManaArgs:    &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:       &ActiveDrawArgs{ret: nil},
+			ActivePrintStatsArgs: &ActivePrintStatsArgs{},
+			ActiveIDArgs:         &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:  &ActiveGetHealthArgs{health: 1},
+			PassiveGetHealthArgs: &PassiveGetHealthArgs{health: 1},
+			PassiveIDArgs:        &PassiveIDArgs{ret: "name2"},
+			want:                 nil,
+		},
+		{
+			name:                 "disconnect forfeits",
+			args:                 args{iter: 1, userInput: []string{game.Disconnected}},
+			ActiveSetManaArgs:    &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:       &ActiveDrawArgs{ret: nil},
+			ActivePrintStatsArgs: &ActivePrintStatsArgs{},
+			ActiveIDArgs:         &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:  &ActiveGetHealthArgs{health: 1},
+			PassiveGetHealthArgs: &PassiveGetHealthArgs{health: 1},
+			PassiveIDArgs:        &PassiveIDArgs{ret: "name2"},
+			want:                 &game.Result{Winners: []string{"name2"}, Losers: []string{"name1"}, Reason: game.ReasonDisconnect},
+		},
+		{
+			name:                 "help, log and hand keep the turn going",
+			args:                 args{iter: 1, userInput: []string{"help", "log", "hand", "-1"}},
+			ActiveSetManaArgs:    &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:       &ActiveDrawArgs{ret: nil},
+			ActivePrintStatsArgs: &ActivePrintStatsArgs{},
+			ActiveIDArgs:         &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:  &ActiveGetHealthArgs{health: 1},
+			PassiveGetHealthArgs: &PassiveGetHealthArgs{health: 1},
+			PassiveIDArgs:        &PassiveIDArgs{ret: "name2"},
+			want:                 nil,
+		},
+		{
+			name:                 "unknown target and attacking without minions are refused",
+			args:                 args{iter: 1, userInput: []string{"play 0 at bob", "attack 0 face", "end"}},
+			ActiveSetManaArgs:    &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:       &ActiveDrawArgs{ret: nil},
+			ActivePrintStatsArgs: &ActivePrintStatsArgs{},
+			ActiveIDArgs:         &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:  &ActiveGetHealthArgs{health: 1},
+			PassiveGetHealthArgs: &PassiveGetHealthArgs{health: 1},
+			PassiveIDArgs:        &PassiveIDArgs{ret: "name2"},
+			want:                 nil,
+		},
+		{
+			name:                   "play at enemy",
+			args:                   args{iter: 1, userInput: []string{"play 0 at enemy"}},
+			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:         &ActiveDrawArgs{ret: nil},
+			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
+			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{0}, damage: []int{5}, err: []error{nil}},
+			ActiveIDArgs:           &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 1},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{false}},
+			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: 5},
+			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
+			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{true}},
+			want:                   &game.Result{Winners: []string{"name1"}, Losers: []string{"name2"}, Reason: game.ReasonKilled},
+		},
+		{
+			name:                  "burn damage kills the active player",
+			args:                  args{iter: 1},
+			ActiveSetManaArgs:     &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:        &ActiveDrawArgs{ret: errs.ErrDeckEmpty},
+			ActiveApplyDamageArgs: &ActiveApplyDamageArgs{damage: 1},
+			ActiveIDArgs:          &ActiveIDArgs{"name1"},
+			ActiveIsDeadArgs:      &ActiveIsDeadArgs{[]bool{true}},
+			PassiveIDArgs:         &PassiveIDArgs{ret: "name2"},
+			PassiveIsDeadArgs:     &PassiveIsDeadArgs{[]bool{false}},
+			want:                  &game.Result{Winners: []string{"name2"}, Losers: []string{"name1"}, Reason: game.ReasonKilled},
+		},
+		{
+			name:                   "both heroes dead is a draw",
+			args:                   args{iter: 1, userInput: []string{"0"}},
+			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:         &ActiveDrawArgs{ret: nil},
+			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
+			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{0}, damage: []int{5}, err: []error{nil}},
+			ActiveIDArgs:           &ActiveIDArgs{"name1"},
+			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 0},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{true}},
+			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: 5},
+			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
+			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{true}},
+			want:                   &game.Result{Winners: []string{"name1", "name2"}, Losers: []string{}, Draw: true, Reason: game.ReasonSimultaneousDeath},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			active := mockPlayer{}
+			if tt.ActiveSetManaArgs != nil {
+				active.On("SetMana", tt.ActiveSetManaArgs.in)
+			}
+			if tt.ActiveDrawArgs != nil {
+				active.On("Draw").Return(tt.ActiveDrawArgs.ret)
+			}
+			if tt.ActiveApplyDamageArgs != nil {
+				active.On("ApplyDamage", tt.ActiveApplyDamageArgs.damage)
+			}
+			if tt.ActivePrintStatsArgs != nil {
+				active.On("PrintStats")
+			}
+			if tt.ActivePlayCardArgs != nil {
+				for ind := range tt.ActivePlayCardArgs.index {
+					active.On("PlayCard", tt.ActivePlayCardArgs.index[ind]).Return(tt.ActivePlayCardArgs.damage[ind], tt.ActivePlayCardArgs.err[ind])
+				}
+			}
+			if tt.ActiveIDArgs != nil {
+				active.On("ID").Return(tt.ActiveIDArgs.ret)
+			}
+			if tt.ActiveGetHealthArgs != nil {
+				active.On("GetHealth").Return(tt.ActiveGetHealthArgs.health)
+			}
+			if tt.ActiveIsDeadArgs != nil {
+				for _, ret := range tt.ActiveIsDeadArgs.ret {
+					active.On("IsDead").Return(ret).Once()
+				}
+			}
+			passive := mockPlayer{}
+			if tt.PassiveGetHealthArgs != nil {
+				passive.On("GetHealth").Return(tt.PassiveGetHealthArgs.health)
+			}
+			if tt.PassiveIDArgs != nil {
+				passive.On("ID").Return(tt.PassiveIDArgs.ret)
+			}
+			if tt.PassiveApplyDamageArgs != nil {
+				passive.On("ApplyDamage", tt.PassiveApplyDamageArgs.damage)
+			}
+			if tt.PassiveIsDeadArgs != nil {
+				for _, ret := range tt.PassiveIsDeadArgs.ret {
+					passive.On("IsDead").Return(ret).Once()
+				}
+			}
+			mockUserInputInst := mockUserInput{input: tt.args.userInput}
+			assert.Equal(t, tt.want, Turn(game.NewRules(game.NewEventLog()))(tt.args.iter, &active, game.NewTable([]game.Player{&active, &passive}, nil), mockUserInputInst.get))
+			active.AssertExpectations(t)
+			passive.AssertExpectations(t)
+		})
+	}
+}
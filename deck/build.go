@@ -0,0 +1,55 @@
+package deck
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+)
+
+// Owner says how many copies of a card someone has
+type Owner interface {
+	Owned(card int) int
+}
+
+// Build makes a deck from cards, refusing any the owner doesn't have enough copies of
+func Build(cards []int, owner Owner, getIndex func(int) int) (*DeckImpl, error) {
+	if err := Check(cards, owner); err != nil {
+		return nil, err
+	}
+	d := NewDeck(getIndex)
+	for _, c := range cards {
+		d.Add(c)
+	}
+	return d, nil
+}
+
+// Check returns an error naming the first card there are more copies of than the owner has
+func Check(cards []int, owner Owner) error {
+	copies := map[int]int{}
+	for _, c := range cards {
+		copies[c]++
+		if copies[c] > owner.Owned(c) {
+			return fmt.Errorf("the deck needs %d copies of %d but only %d are owned", count(cards, c), c, owner.Owned(c))
+		}
+	}
+	return nil
+}
+
+// ID names a deck list by its cards in any order, it's the same for every list of the same cards
+func ID(cards []int) string {
+	sorted := append([]int{}, cards...)
+	sort.Ints(sorted)
+	h := fnv.New32a()
+	fmt.Fprint(h, sorted)
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+func count(cards []int, card int) int {
+	n := 0
+	for _, c := range cards {
+		if c == card {
+			n++
+		}
+	}
+	return n
+}
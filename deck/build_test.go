@@ -0,0 +1,60 @@
+package deck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type owned map[int]int
+
+func (o owned) Owned(card int) int {
+	return o[card]
+}
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		name    string
+		cards   []int
+		owner   owned
+		want    []int
+		wantErr string
+	}{
+		{
+			name:  "all owned",
+			cards: []int{1, 1, 2},
+			owner: owned{1: 2, 2: 1},
+			want:  []int{1, 1, 2},
+		},
+		{
+			name:    "too many copies",
+			cards:   []int{1, 2, 1, 1},
+			owner:   owned{1: 2, 2: 1},
+			wantErr: "the deck needs 3 copies of 1 but only 2 are owned",
+		},
+		{
+			name:    "not owned",
+			cards:   []int{8},
+			owner:   owned{},
+			wantErr: "the deck needs 1 copies of 8 but only 0 are owned",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Build(tt.cards, tt.owner, func(int) int { return 0 })
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.cards)
+		})
+	}
+}
+
+func TestID(t *testing.T) {
+	assert.Equal(t, ID([]int{1, 2, 2, 3}), ID([]int{3, 2, 1, 2}), "the order doesn't matter")
+	assert.NotEqual(t, ID([]int{1, 2, 2, 3}), ID([]int{1, 2, 3, 3}))
+	assert.Len(t, ID(nil), 8)
+}
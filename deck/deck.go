@@ -1,8 +1,20 @@
 package deck
 
+import (
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+)
+
+// DeckImpl is an ordered deck drawn from the top, cards added to it are shuffled in before it's next
+// used so it's shuffled once at the start of a game
 type DeckImpl struct {
+	// getIndex is the deck's randomness, like rand.Intn, for shuffles and where inserted cards go
 	getIndex func(int) int
-	cards    []int
+	// cards are in order, the top first
+	cards []int
+	// shuffled is false while cards have been added since the last shuffle
+	shuffled bool
+	// ordered decks keep the order cards were added in
+	ordered bool
 }
 
 func NewDeck(getIndex func(int) int) *DeckImpl {
@@ -12,16 +24,44 @@ func NewDeck(getIndex func(int) int) *DeckImpl {
 	}
 }
 
-func (d *DeckImpl) Draw() int {
+// NewOrderedDeck is drawn in the order cards are added, for scripted games like puzzles. Inserted
+// cards go on top.
+func NewOrderedDeck() *DeckImpl {
+	return &DeckImpl{
+		getIndex: func(int) int { return 0 },
+		cards:    []int{},
+		ordered:  true,
+	}
+}
+
+func (d *DeckImpl) Draw() (int, error) {
+	d.ready()
 	if len(d.cards) == 0 {
-		return -1
+		return 0, errs.ErrDeckEmpty
 	}
-	ind := d.getIndex(len(d.cards))
-	val := d.cards[ind]
-	d.cards = append(d.cards[:ind], d.cards[ind+1:]...)
-	return val
+	val := d.cards[0]
+	d.cards = d.cards[1:]
+	return val, nil
 }
 
+// Add puts c in the deck, it's shuffled in before the deck is next used
 func (d *DeckImpl) Add(c int) {
 	d.cards = append(d.cards, c)
+	d.shuffled = false
+}
+
+// ready shuffles in the cards added since the last shuffle
+func (d *DeckImpl) ready() {
+	if !d.ordered && !d.shuffled {
+		d.Shuffle(d.getIndex)
+	}
+}
+
+// Insert shuffles c into the deck at a position picked by getIndex
+func (d *DeckImpl) Insert(c int) {
+	d.ready()
+	ind := d.getIndex(len(d.cards) + 1)
+	d.cards = append(d.cards, 0)
+	copy(d.cards[ind+1:], d.cards[ind:])
+	d.cards[ind] = c
 }
@@ -1,9 +1,11 @@
 package deck
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
+	"github.com/ShookieShookie/WorkshopImpl/errs"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,19 +13,32 @@ func TestDeckImpl_Draw(t *testing.T) {
 	type fields struct {
 		getIndex func(int) int
 		cards    []int
+		shuffled bool
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		want   int
+		name     string
+		fields   fields
+		want     int
+		wantErr  bool
+		wantLeft []int
 	}{
 		{
-			name: "call to nonempty deck",
+			name: "draws the top",
 			fields: fields{
-				cards:    []int{1, 2, 3, 4, 5},
-				getIndex: func(i int) int { return 2 },
+				cards:    []int{3, 1, 2},
+				shuffled: true,
+			},
+			want:     3,
+			wantLeft: []int{1, 2},
+		},
+		{
+			name: "shuffles added cards first",
+			fields: fields{
+				cards:    []int{1, 2, 3},
+				getIndex: func(i int) int { return 0 },
 			},
-			want: 3,
+			want:     2,
+			wantLeft: []int{3, 1},
 		},
 		{
 			name: "call to empty deck",
@@ -31,7 +46,8 @@ func TestDeckImpl_Draw(t *testing.T) {
 				cards:    []int{},
 				getIndex: func(i int) int { return 2 },
 			},
-			want: -1,
+			wantErr:  true,
+			wantLeft: []int{},
 		},
 	}
 	for _, tt := range tests {
@@ -39,14 +55,33 @@ func TestDeckImpl_Draw(t *testing.T) {
 			d := &DeckImpl{
 				getIndex: tt.fields.getIndex,
 				cards:    tt.fields.cards,
+				shuffled: tt.fields.shuffled,
 			}
-			if got := d.Draw(); got != tt.want {
+			got, err := d.Draw()
+			if got != tt.want {
 				t.Errorf("DeckImpl.Draw() = %v, want %v", got, tt.want)
 			}
+			if (err != nil) != tt.wantErr || (err != nil && !errors.Is(err, errs.ErrDeckEmpty)) {
+				t.Errorf("DeckImpl.Draw() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.wantLeft, d.cards)
 		})
 	}
 }
 
+func TestDeckImpl_DrawShufflesOnce(t *testing.T) {
+	shuffles := 0
+	d := NewDeck(func(i int) int {
+		shuffles++
+		return i - 1
+	})
+	d.Add(1)
+	d.Add(2)
+	d.Draw()
+	d.Draw()
+	assert.Equal(t, 1, shuffles, "a two card deck takes one swap to shuffle and isn't shuffled again")
+}
+
 func TestDeckImpl_Add(t *testing.T) {
 	type fields struct {
 		getIndex func(i int) int
@@ -81,6 +116,37 @@ func TestDeckImpl_Add(t *testing.T) {
 			}
 			d.Add(tt.args.c)
 			assert.Equal(t, tt.want, d.cards)
+			assert.False(t, d.shuffled, "added cards are shuffled in before the next draw")
+		})
+	}
+}
+
+func TestDeckImpl_Insert(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []int
+		index int
+		want  []int
+	}{
+		{name: "empty", cards: []int{}, index: 0, want: []int{9}},
+		{name: "top", cards: []int{1, 2}, index: 0, want: []int{9, 1, 2}},
+		{name: "middle", cards: []int{1, 2}, index: 1, want: []int{1, 9, 2}},
+		{name: "bottom", cards: []int{1, 2}, index: 2, want: []int{1, 2, 9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n int
+			d := &DeckImpl{
+				getIndex: func(i int) int {
+					n = i
+					return tt.index
+				},
+				cards:    tt.cards,
+				shuffled: true,
+			}
+			d.Insert(9)
+			assert.Equal(t, tt.want, d.cards)
+			assert.Equal(t, len(tt.want), n, "every position is a choice, the bottom included")
 		})
 	}
 }
@@ -113,3 +179,12 @@ func TestNewDeck(t *testing.T) {
 		})
 	}
 }
+
+func TestNewOrderedDeck(t *testing.T) {
+	d := NewOrderedDeck()
+	for _, c := range []int{3, 1, 2} {
+		d.Add(c)
+	}
+	d.Insert(7)
+	assert.Equal(t, []int{7, 3, 1, 2}, d.Peek(d.Len()))
+}
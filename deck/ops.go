@@ -0,0 +1,122 @@
+package deck
+
+import (
+	"fmt"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+)
+
+func (d *DeckImpl) Len() int {
+	return len(d.cards)
+}
+
+// Shuffle reorders the whole deck, random picks like rand.Intn so a seeded source gives a repeatable order
+func (d *DeckImpl) Shuffle(random func(int) int) {
+	for i := len(d.cards) - 1; i > 0; i-- {
+		j := random(i + 1)
+		d.cards[i], d.cards[j] = d.cards[j], d.cards[i]
+	}
+	d.shuffled = true
+}
+
+// Peek returns a copy of the top n cards, fewer if the deck is smaller. The top is the front of the
+// deck, where a deck that always draws index 0 draws from.
+func (d *DeckImpl) Peek(n int) []int {
+	d.ready()
+	n = max(0, min(n, len(d.cards)))
+	return append([]int{}, d.cards[:n]...)
+}
+
+// Scry looks at the top len(top)+len(bottom) cards and rearranges them, top and bottom are positions
+// from the top in the order the cards go back, the bottom ones under the rest of the deck
+func (d *DeckImpl) Scry(top, bottom []int) error {
+	d.ready()
+	n := len(top) + len(bottom)
+	if n > len(d.cards) {
+		return fmt.Errorf("%w, can't scry %d cards with %d left", errs.ErrDeckEmpty, n, len(d.cards))
+	}
+	seen := make([]bool, n)
+	for _, i := range append(append([]int{}, top...), bottom...) {
+		if i < 0 || i >= n || seen[i] {
+			return fmt.Errorf("%w %d, scry needs each of the top %d cards placed exactly once", errs.ErrInvalidIndex, i, n)
+		}
+		seen[i] = true
+	}
+	cards := make([]int, 0, len(d.cards))
+	for _, i := range top {
+		cards = append(cards, d.cards[i])
+	}
+	cards = append(cards, d.cards[n:]...)
+	for _, i := range bottom {
+		cards = append(cards, d.cards[i])
+	}
+	d.cards = cards
+	return nil
+}
+
+// DrawWhere draws the topmost card that matches, like "draw a 1-cost card"
+func (d *DeckImpl) DrawWhere(match func(card int) bool) (int, bool) {
+	d.ready()
+	for i, c := range d.cards {
+		if match(c) {
+			d.cards = append(d.cards[:i], d.cards[i+1:]...)
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+func (d *DeckImpl) InsertTop(c int) {
+	d.ready()
+	d.cards = append([]int{c}, d.cards...)
+}
+
+func (d *DeckImpl) InsertBottom(c int) {
+	d.ready()
+	d.cards = append(d.cards, c)
+}
+
+// Count is how many copies of card are left in the deck
+func (d *DeckImpl) Count(card int) int {
+	n := 0
+	for _, c := range d.cards {
+		if c == card {
+			n++
+		}
+	}
+	return n
+}
+
+// Remove takes the topmost copy of card out of the deck, it reports whether there was one
+func (d *DeckImpl) Remove(card int) bool {
+	return d.RemoveN(card, 1) == 1
+}
+
+// RemoveN takes up to n copies of card out of the deck from the top, it returns how many it removed
+func (d *DeckImpl) RemoveN(card, n int) int {
+	removed := 0
+	cards := d.cards[:0]
+	for _, c := range d.cards {
+		if c == card && removed < n {
+			removed++
+			continue
+		}
+		cards = append(cards, c)
+	}
+	d.cards = cards
+	return removed
+}
+
+func min(i, j int) int {
+	if i < j {
+		return i
+	}
+	return j
+}
+
+func max(i, j int) int {
+	if i > j {
+		return i
+	}
+	return j
+}
@@ -0,0 +1,108 @@
+package deck
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeckImpl_Shuffle(t *testing.T) {
+	shuffled := func(seed int64) []int {
+		d := &DeckImpl{cards: []int{1, 2, 3, 4, 5, 6, 7, 8}, shuffled: true}
+		d.Shuffle(rand.New(rand.NewSource(seed)).Intn)
+		return d.cards
+	}
+	assert.Equal(t, shuffled(7), shuffled(7), "the same seed shuffles the same way")
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, shuffled(7))
+
+	d := &DeckImpl{cards: []int{1, 2, 3}, shuffled: true}
+	d.Shuffle(func(n int) int { return 0 })
+	assert.Equal(t, []int{2, 3, 1}, d.cards)
+}
+
+func TestDeckImpl_Peek(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{name: "top two", n: 2, want: []int{1, 2}},
+		{name: "more than the deck", n: 5, want: []int{1, 2, 3}},
+		{name: "none", n: 0, want: []int{}},
+		{name: "negative", n: -1, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &DeckImpl{cards: []int{1, 2, 3}, shuffled: true}
+			got := d.Peek(tt.n)
+			assert.Equal(t, tt.want, got)
+			if len(got) > 0 {
+				got[0] = 9
+			}
+			assert.Equal(t, []int{1, 2, 3}, d.cards, "peeking can't change the deck")
+		})
+	}
+}
+
+func TestDeckImpl_Scry(t *testing.T) {
+	tests := []struct {
+		name    string
+		top     []int
+		bottom  []int
+		want    []int
+		wantErr bool
+	}{
+		{name: "keep", top: []int{0, 1}, want: []int{1, 2, 3, 4}},
+		{name: "reorder", top: []int{1, 0}, want: []int{2, 1, 3, 4}},
+		{name: "bottom", top: []int{1}, bottom: []int{0}, want: []int{2, 3, 4, 1}},
+		{name: "bottom in order", bottom: []int{1, 0}, want: []int{3, 4, 2, 1}},
+		{name: "card placed twice", top: []int{0}, bottom: []int{0}, want: []int{1, 2, 3, 4}, wantErr: true},
+		{name: "past the cards seen", top: []int{2}, want: []int{1, 2, 3, 4}, wantErr: true},
+		{name: "more than the deck", top: []int{0, 1, 2, 3, 4}, want: []int{1, 2, 3, 4}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &DeckImpl{cards: []int{1, 2, 3, 4}, shuffled: true}
+			err := d.Scry(tt.top, tt.bottom)
+			assert.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, errs.ErrInvalidIndex) || errors.Is(err, errs.ErrDeckEmpty))
+			}
+			assert.Equal(t, tt.want, d.cards)
+		})
+	}
+}
+
+func TestDeckImpl_DrawWhere(t *testing.T) {
+	d := &DeckImpl{cards: []int{5, 1, 3, 1}, shuffled: true}
+	got, ok := d.DrawWhere(func(c int) bool { return c == 1 })
+	assert.True(t, ok)
+	assert.Equal(t, 1, got)
+	assert.Equal(t, []int{5, 3, 1}, d.cards)
+
+	_, ok = d.DrawWhere(func(c int) bool { return c > 8 })
+	assert.False(t, ok)
+	assert.Equal(t, []int{5, 3, 1}, d.cards)
+}
+
+func TestDeckImpl_InsertTopBottom(t *testing.T) {
+	d := &DeckImpl{cards: []int{1, 2}, shuffled: true}
+	d.InsertTop(0)
+	d.InsertBottom(3)
+	assert.Equal(t, []int{0, 1, 2, 3}, d.cards)
+	assert.Equal(t, 4, d.Len())
+}
+
+func TestDeckImpl_Remove(t *testing.T) {
+	d := &DeckImpl{cards: []int{2, 1, 2, 3, 2}, shuffled: true}
+	assert.Equal(t, 3, d.Count(2))
+	assert.True(t, d.Remove(2))
+	assert.Equal(t, []int{1, 2, 3, 2}, d.cards)
+	assert.Equal(t, 2, d.RemoveN(2, 5))
+	assert.Equal(t, []int{1, 3}, d.cards)
+	assert.False(t, d.Remove(2))
+	assert.Equal(t, 0, d.Count(2))
+}
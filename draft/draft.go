@@ -0,0 +1,224 @@
+package draft
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ShookieShookie/WorkshopImpl/deck"
+)
+
+type Rarity string
+
+const (
+	Common    Rarity = "common"
+	Rare      Rarity = "rare"
+	Epic      Rarity = "epic"
+	Legendary Rarity = "legendary"
+)
+
+// rarities is the order offers are weighted in, weights are relative chances of an offer being that rarity
+var rarities = []Rarity{Common, Rare, Epic, Legendary}
+
+var weights = map[Rarity]int{
+	Common:    70,
+	Rare:      20,
+	Epic:      8,
+	Legendary: 2,
+}
+
+// offerSize is how many cards a player chooses between each pick
+const offerSize = 3
+
+type Card struct {
+	Value  int
+	Rarity Rarity
+}
+
+func (c Card) String() string {
+	return fmt.Sprintf("%d (%s)", c.Value, c.Rarity)
+}
+
+// DefaultCatalog is every card value the base deck uses, the stronger ones rarer
+func DefaultCatalog() []Card {
+	return []Card{
+		{Value: 0, Rarity: Common},
+		{Value: 1, Rarity: Common},
+		{Value: 2, Rarity: Common},
+		{Value: 3, Rarity: Common},
+		{Value: 4, Rarity: Rare},
+		{Value: 5, Rarity: Rare},
+		{Value: 6, Rarity: Epic},
+		{Value: 7, Rarity: Epic},
+		{Value: 8, Rarity: Legendary},
+	}
+}
+
+var errNoOffer = errors.New("no cards on offer")
+var errNoRarity = errors.New("the catalog has no cards of a rarity that can be rolled")
+var errDraftDone = errors.New("the deck is already complete")
+
+// Draft builds a deck one pick at a time from random offers out of a catalog
+type Draft struct {
+	catalog []Card
+	size    int
+	random  func(int) int
+	offer   []Card
+	picks   []int
+}
+
+// NewDraft drafts a deck of size cards, random returns an int in [0, n) like rand.Intn
+func NewDraft(catalog []Card, size int, random func(int) int) *Draft {
+	return &Draft{
+		catalog: catalog,
+		size:    size,
+		random:  random,
+		picks:   []int{},
+	}
+}
+
+// Offer returns the cards to choose between for the next pick, it's the same until a pick is made
+func (d *Draft) Offer() ([]Card, error) {
+	if d.Done() {
+		return nil, nil
+	}
+	if d.offer == nil {
+		offer, err := d.newOffer()
+		if err != nil {
+			return nil, err
+		}
+		d.offer = offer
+	}
+	return d.offer, nil
+}
+
+// newOffer fills the offer from the rolled rarity, when it's short of cards the nearest rarities top it up
+func (d *Draft) newOffer() ([]Card, error) {
+	rarity, err := d.rarity()
+	if err != nil {
+		return nil, err
+	}
+	offer := []Card{}
+	for _, r := range nearest(rarity) {
+		pool := d.ofRarity(r)
+		for len(offer) < offerSize && len(pool) > 0 {
+			i := d.random(len(pool))
+			offer = append(offer, pool[i])
+			pool = append(pool[:i], pool[i+1:]...)
+		}
+	}
+	return offer, nil
+}
+
+// nearest orders every rarity by how far it is from r, the lower first when two are as far
+func nearest(r Rarity) []Rarity {
+	at := 0
+	for i, o := range rarities {
+		if o == r {
+			at = i
+		}
+	}
+	order := []Rarity{r}
+	for step := 1; step < len(rarities); step++ {
+		if at-step >= 0 {
+			order = append(order, rarities[at-step])
+		}
+		if at+step < len(rarities) {
+			order = append(order, rarities[at+step])
+		}
+	}
+	return order
+}
+
+// rarity rolls the rarity of an offer, only counting rarities the catalog has
+func (d *Draft) rarity() (Rarity, error) {
+	total := 0
+	for _, r := range rarities {
+		if len(d.ofRarity(r)) > 0 {
+			total += weights[r]
+		}
+	}
+	if total == 0 {
+		return "", errNoRarity
+	}
+	roll := d.random(total)
+	for _, r := range rarities {
+		if len(d.ofRarity(r)) == 0 {
+			continue
+		}
+		if roll < weights[r] {
+			return r, nil
+		}
+		roll -= weights[r]
+	}
+	return Common, nil
+}
+
+func (d *Draft) ofRarity(r Rarity) []Card {
+	cards := []Card{}
+	for _, c := range d.catalog {
+		if c.Rarity == r {
+			cards = append(cards, c)
+		}
+	}
+	return cards
+}
+
+// Pick takes card i of the current offer into the deck
+func (d *Draft) Pick(i int) error {
+	if d.Done() {
+		return errDraftDone
+	}
+	offer, err := d.Offer()
+	if err != nil {
+		return err
+	}
+	if len(offer) == 0 {
+		return errNoOffer
+	}
+	if i < 0 || i >= len(offer) {
+		return fmt.Errorf("pick one of 0 to %d", len(offer)-1)
+	}
+	d.picks = append(d.picks, offer[i].Value)
+	d.offer = nil
+	return nil
+}
+
+// Picked returns how many cards have been taken so far
+func (d *Draft) Picked() int {
+	return len(d.picks)
+}
+
+func (d *Draft) Done() bool {
+	return len(d.picks) >= d.size
+}
+
+// Cards returns the drafted card values
+func (d *Draft) Cards() []int {
+	return append([]int{}, d.picks...)
+}
+
+// NewDeck puts the drafted cards into a deck
+func (d *Draft) NewDeck(getIndex func(int) int) *deck.DeckImpl {
+	dk := deck.NewDeck(getIndex)
+	for _, c := range d.picks {
+		dk.Add(c)
+	}
+	return dk
+}
+
+// AutoPick finishes the draft choosing at random, for opponents nobody drafts for
+func (d *Draft) AutoPick() error {
+	for !d.Done() {
+		offer, err := d.Offer()
+		if err != nil {
+			return err
+		}
+		if len(offer) == 0 {
+			return errNoOffer
+		}
+		if err := d.Pick(d.random(len(offer))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package draft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rolls returns the given values in order, each taken modulo n
+type rolls struct {
+	values []int
+	called int
+}
+
+func (r *rolls) next(n int) int {
+	v := r.values[r.called%len(r.values)]
+	r.called++
+	return v % n
+}
+
+func TestDraft_Offer(t *testing.T) {
+	tests := []struct {
+		name    string
+		catalog []Card
+		rolls   []int
+		want    []Card
+	}{
+		{
+			name:    "common roll offers commons",
+			catalog: DefaultCatalog(),
+			rolls:   []int{0, 3, 0, 0},
+			want:    []Card{{Value: 3, Rarity: Common}, {Value: 0, Rarity: Common}, {Value: 1, Rarity: Common}},
+		},
+		{
+			name:    "legendary roll with too few legendaries is topped up with epics",
+			catalog: DefaultCatalog(),
+			rolls:   []int{99, 8, 0, 0},
+			want:    []Card{{Value: 8, Rarity: Legendary}, {Value: 6, Rarity: Epic}, {Value: 7, Rarity: Epic}},
+		},
+		{
+			name:    "epic roll is topped up with the rarity below before the one above",
+			catalog: DefaultCatalog(),
+			rolls:   []int{90, 1, 0, 0},
+			want:    []Card{{Value: 7, Rarity: Epic}, {Value: 6, Rarity: Epic}, {Value: 4, Rarity: Rare}},
+		},
+		{
+			name:    "rare roll",
+			catalog: []Card{{1, Common}, {4, Rare}, {5, Rare}, {6, Rare}},
+			rolls:   []int{75, 2, 0, 0},
+			want:    []Card{{Value: 6, Rarity: Rare}, {Value: 4, Rarity: Rare}, {Value: 5, Rarity: Rare}},
+		},
+		{
+			name:    "rarities missing from the catalog aren't rolled",
+			catalog: []Card{{1, Common}, {4, Rare}, {5, Rare}, {6, Rare}},
+			rolls:   []int{89, 0, 0, 0},
+			want:    []Card{{Value: 4, Rarity: Rare}, {Value: 5, Rarity: Rare}, {Value: 6, Rarity: Rare}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &rolls{values: tt.rolls}
+			d := NewDraft(tt.catalog, 2, r.next)
+			got, err := d.Offer()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			got, _ = d.Offer()
+			assert.Equal(t, tt.want, got, "the offer stays until a pick")
+		})
+	}
+}
+
+func TestDraft_Pick(t *testing.T) {
+	r := &rolls{values: []int{0}}
+	d := NewDraft(DefaultCatalog(), 2, r.next)
+	assert.Error(t, d.Pick(3))
+	assert.Error(t, d.Pick(-1))
+	assert.NoError(t, d.Pick(1))
+	assert.Equal(t, 1, d.Picked())
+	assert.False(t, d.Done())
+	assert.NoError(t, d.Pick(2))
+	assert.True(t, d.Done())
+	assert.Equal(t, errDraftDone, d.Pick(0))
+	offer, err := d.Offer()
+	assert.NoError(t, err)
+	assert.Nil(t, offer)
+	assert.Equal(t, []int{1, 2}, d.Cards())
+}
+
+func TestDraft_NothingToRoll(t *testing.T) {
+	tests := []struct {
+		name    string
+		catalog []Card
+	}{
+		{name: "empty catalog"},
+		{name: "unknown rarity", catalog: []Card{{Value: 1, Rarity: "mythic"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDraft(tt.catalog, 2, (&rolls{values: []int{0}}).next)
+			_, err := d.Offer()
+			assert.Equal(t, errNoRarity, err)
+			assert.Equal(t, errNoRarity, d.Pick(0))
+			assert.Equal(t, errNoRarity, d.AutoPick())
+		})
+	}
+}
+
+func TestDraft_NewDeck(t *testing.T) {
+	d := NewDraft(DefaultCatalog(), 3, (&rolls{values: []int{0}}).next)
+	assert.NoError(t, d.AutoPick())
+	assert.True(t, d.Done())
+	dk := d.NewDeck(func(int) int { return 0 })
+	assert.ElementsMatch(t, d.Cards(), dk.Peek(dk.Len()))
+}
+
+func TestCard_String(t *testing.T) {
+	assert.Equal(t, "4 (rare)", Card{Value: 4, Rarity: Rare}.String())
+}
+
+func TestNearest(t *testing.T) {
+	assert.Equal(t, []Rarity{Common, Rare, Epic, Legendary}, nearest(Common))
+	assert.Equal(t, []Rarity{Epic, Rare, Legendary, Common}, nearest(Epic))
+	assert.Equal(t, []Rarity{Legendary, Epic, Rare, Common}, nearest(Legendary))
+}
@@ -0,0 +1,28 @@
+package draft
+
+// Run is an arena run with a drafted deck, it's over after maxWins wins or maxLosses losses
+type Run struct {
+	Wins      int
+	Losses    int
+	maxWins   int
+	maxLosses int
+}
+
+func NewRun(maxWins, maxLosses int) *Run {
+	return &Run{
+		maxWins:   maxWins,
+		maxLosses: maxLosses,
+	}
+}
+
+func (r *Run) Record(won bool) {
+	if won {
+		r.Wins++
+	} else {
+		r.Losses++
+	}
+}
+
+func (r *Run) Over() bool {
+	return r.Wins >= r.maxWins || r.Losses >= r.maxLosses
+}
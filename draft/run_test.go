@@ -0,0 +1,19 @@
+package draft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	r := NewRun(2, 1)
+	r.Record(true)
+	assert.False(t, r.Over())
+	r.Record(true)
+	assert.True(t, r.Over())
+
+	r = NewRun(2, 1)
+	r.Record(false)
+	assert.True(t, r.Over())
+}
@@ -0,0 +1,128 @@
+package effect
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/secret"
+)
+
+// effect cards are numbered past the secrets
+const (
+	Conjure   = 14
+	BombToss  = 15
+	Bomb      = 16
+	Transmute = 17
+	WildBolt  = 18
+)
+
+var costs = map[int]int{
+	Conjure:   1,
+	BombToss:  2,
+	Bomb:      0,
+	Transmute: 1,
+	WildBolt:  3,
+}
+
+// Pool is the cards effects can generate, by value with what they cost
+type Pool map[int]int
+
+// DefaultPool is every card a player can own, damage cards, secrets and effects. Class cards and
+// bombs are left out.
+func DefaultPool() Pool {
+	pool := Pool{}
+	for v := 0; v <= 8; v++ {
+		pool[v] = v
+	}
+	for _, v := range secret.Values() {
+		pool[v] = secret.Cost(v)
+	}
+	for _, v := range Values() {
+		pool[v] = Cost(v)
+	}
+	return pool
+}
+
+// Random picks a card costing cost, random picks among them like rand.Intn
+func (p Pool) Random(cost int, random func(int) int) (int, bool) {
+	cards := []int{}
+	for v, c := range p {
+		if c == cost {
+			cards = append(cards, v)
+		}
+	}
+	if len(cards) == 0 {
+		return 0, false
+	}
+	sort.Ints(cards)
+	return cards[random(len(cards))], true
+}
+
+// Cards are the effects by card value, for the game's ruleset. Generated cards come from pool and
+// random makes every random choice like rand.Intn.
+func Cards(pool Pool, random func(int) int) map[int]game.Effect {
+	return map[int]game.Effect{
+		Conjure: {Name: "Conjure", Cast: func(caster, target game.Player) (int, string) {
+			h, ok := game.HolderOf(caster)
+			card, found := pool.Random(3, random)
+			if !ok || !found {
+				return 0, "finds nothing"
+			}
+			h.AddToHand(card)
+			return 0, fmt.Sprintf("adds a random 3-cost card to %s's hand", caster.ID())
+		}},
+		BombToss: {Name: "Bomb Toss", Targeted: true, Cast: func(caster, target game.Player) (int, string) {
+			h, ok := game.HolderOf(target)
+			if !ok {
+				return 0, "misses"
+			}
+			for i := 0; i < 3; i++ {
+				h.ShuffleIntoDeck(Bomb)
+			}
+			return 0, fmt.Sprintf("shuffles 3 bombs into %s's deck", target.ID())
+		}},
+		Bomb: {Name: "Bomb", Drawn: func(drawer game.Player) string {
+			drawer.ApplyDamage(3)
+			return fmt.Sprintf("explodes for 3 damage to %s", drawer.ID())
+		}},
+		Transmute: {Name: "Transmute", Cast: func(caster, target game.Player) (int, string) {
+			h, ok := game.HolderOf(caster)
+			if !ok || len(h.ShowHand()) == 0 {
+				return 0, "has nothing to transform"
+			}
+			i := random(len(h.ShowHand()))
+			card, found := pool.Random(Cost(h.ShowHand()[i])+1, random)
+			if !found {
+				return 0, fmt.Sprintf("fails to transform card %d", i)
+			}
+			h.Transform(i, card)
+			return 0, fmt.Sprintf("transforms card %d into a card costing one more", i)
+		}},
+		WildBolt: {Name: "Wild Bolt", Targeted: true, Cast: func(caster, target game.Player) (int, string) {
+			damage := 1 + random(6)
+			return damage, fmt.Sprintf("rolls %d", damage)
+		}},
+	}
+}
+
+// Values lists the effect cards players can own in order, bombs only come from Bomb Toss
+func Values() []int {
+	values := []int{}
+	for v := range costs {
+		if v != Bomb {
+			values = append(values, v)
+		}
+	}
+	sort.Ints(values)
+	return values
+}
+
+// Cost is what a card costs to play, effects and secrets have their own costs and every other card
+// costs its value
+func Cost(value int) int {
+	if c, ok := costs[value]; ok {
+		return c
+	}
+	return secret.Cost(value)
+}
@@ -0,0 +1,151 @@
+package effect
+
+import (
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/command"
+	"github.com/ShookieShookie/WorkshopImpl/deck"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/hand"
+	"github.com/ShookieShookie/WorkshopImpl/player"
+	"github.com/ShookieShookie/WorkshopImpl/secret"
+	"github.com/stretchr/testify/assert"
+)
+
+// first always picks the first choice, which is also the top of a deck
+func first(int) int { return 0 }
+
+func newPlayer(id string, cards ...int) *player.PlayerImpl {
+	h := hand.NewHand()
+	for _, c := range cards {
+		h.Add(c)
+	}
+	return player.NewPlayer(id, 10, 0, h, deck.NewOrderedDeck())
+}
+
+func TestPool_Random(t *testing.T) {
+	pool := DefaultPool()
+	tests := []struct {
+		name   string
+		cost   int
+		pick   int
+		want   int
+		wantOk bool
+	}{
+		{name: "damage card", cost: 3, pick: 0, want: 3, wantOk: true},
+		{name: "secret", cost: 3, pick: 1, want: 11, wantOk: true},
+		{name: "effect", cost: 3, pick: 3, want: WildBolt, wantOk: true},
+		{name: "nothing costs that", cost: 9, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var choices int
+			got, ok := pool.Random(tt.cost, func(n int) int {
+				choices = n
+				return tt.pick
+			})
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, 4, choices, "3, Counterspell, Ice Block and Wild Bolt cost 3")
+			}
+		})
+	}
+}
+
+func TestDefaultPool(t *testing.T) {
+	pool := DefaultPool()
+	assert.NotContains(t, pool, Bomb)
+	assert.NotContains(t, pool, 9, "class cards aren't for everyone")
+	assert.Equal(t, 1, pool[Conjure])
+	assert.Equal(t, 2, pool[12])
+}
+
+func TestCards(t *testing.T) {
+	pool := Pool{3: 3, 4: 4, 11: 3}
+	t.Run("Conjure", func(t *testing.T) {
+		p := newPlayer("p1", 1)
+		damage, text := Cards(pool, func(int) int { return 1 })[Conjure].Cast(p, nil)
+		assert.Equal(t, 0, damage)
+		assert.Equal(t, "adds a random 3-cost card to p1's hand", text)
+		assert.Equal(t, []int{1, 11}, p.ShowHand())
+	})
+	t.Run("Bomb Toss", func(t *testing.T) {
+		caster, target := newPlayer("p1"), newPlayer("p2")
+		e := Cards(pool, first)[BombToss]
+		assert.True(t, e.Targeted)
+		_, text := e.Cast(caster, target)
+		assert.Equal(t, "shuffles 3 bombs into p2's deck", text)
+		for i := 0; i < 3; i++ {
+			assert.Nil(t, target.Draw())
+		}
+		assert.Equal(t, []int{Bomb, Bomb, Bomb}, target.ShowHand())
+		assert.Empty(t, caster.ShowHand())
+	})
+	t.Run("Bomb", func(t *testing.T) {
+		p := newPlayer("p1")
+		text := Cards(pool, first)[Bomb].Drawn(p)
+		assert.Equal(t, "explodes for 3 damage to p1", text)
+		assert.Equal(t, 7, p.GetHealth())
+	})
+	t.Run("Transmute", func(t *testing.T) {
+		p := newPlayer("p1", 1, 3)
+		picks := []int{1, 0}
+		_, text := Cards(pool, func(int) int {
+			pick := picks[0]
+			picks = picks[1:]
+			return pick
+		})[Transmute].Cast(p, nil)
+		assert.Equal(t, "transforms card 1 into a card costing one more", text)
+		assert.Equal(t, []int{1, 4}, p.ShowHand())
+	})
+	t.Run("Transmute with nothing costing more", func(t *testing.T) {
+		p := newPlayer("p1", 4)
+		_, text := Cards(pool, first)[Transmute].Cast(p, nil)
+		assert.Equal(t, "fails to transform card 0", text)
+		assert.Equal(t, []int{4}, p.ShowHand())
+	})
+	t.Run("Wild Bolt", func(t *testing.T) {
+		damage, text := Cards(pool, func(n int) int { return n - 1 })[WildBolt].Cast(newPlayer("p1"), newPlayer("p2"))
+		assert.Equal(t, 6, damage)
+		assert.Equal(t, "rolls 6", text)
+	})
+}
+
+func TestCost(t *testing.T) {
+	assert.Equal(t, 2, Cost(BombToss))
+	assert.Equal(t, 0, Cost(Bomb))
+	assert.Equal(t, 3, Cost(11))
+	assert.Equal(t, 5, Cost(5))
+	assert.Equal(t, []int{Conjure, BombToss, Transmute, WildBolt}, Values())
+}
+
+func TestCounterspell_BombToss(t *testing.T) {
+	ruleset := game.DefaultRuleset()
+	ruleset.Secrets = secret.Cards()
+	ruleset.Effects = Cards(DefaultPool(), first)
+	rules := game.NewRulesWith(game.NewEventLog(), ruleset)
+	p1 := newPlayer("p1", BombToss)
+	p1.SetCardCost(Cost)
+	d := deck.NewOrderedDeck()
+	h := hand.NewHand()
+	h.Add(11)
+	p2 := player.NewPlayer("p2", 10, 0, h, d)
+	p2.SetCardCost(Cost)
+	table := game.NewTable([]game.Player{p1, p2}, nil)
+	script := func(lines ...string) func() string {
+		return func() string {
+			line := lines[0]
+			lines = lines[1:]
+			return line
+		}
+	}
+
+	command.Turn(rules)(3, p2, table, script("play 0", "end"))
+	assert.Equal(t, 1, rules.Secrets(p2))
+	command.Turn(rules)(4, p1, table, script("play 0", "end"))
+
+	assert.Equal(t, 0, rules.Secrets(p2), "Counterspell was used up")
+	assert.Equal(t, 0, d.Len(), "the countered Bomb Toss shuffled no bombs in")
+	assert.Empty(t, p1.ShowHand())
+}
@@ -0,0 +1,19 @@
+// Package errs has the errors callers branch on, the packages that return them wrap them with details
+// so check with errors.Is
+package errs
+
+import (
+	"errors"
+)
+
+var (
+	// ErrInvalidIndex is a card index outside the hand or the cards being looked at
+	ErrInvalidIndex  = errors.New("invalid card index")
+	ErrNotEnoughMana = errors.New("not enough mana")
+	ErrDeckEmpty     = errors.New("no cards in deck")
+	ErrHandFull      = errors.New("hand is full")
+	// ErrNotYourTurn is an action from a player who isn't active
+	ErrNotYourTurn = errors.New("not your turn")
+	// ErrIllegalAction is an action the rules don't allow right now, like a second hero power in a turn
+	ErrIllegalAction = errors.New("illegal action")
+)
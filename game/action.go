@@ -0,0 +1,74 @@
+package game
+
+import (
+	"fmt"
+)
+
+type ActionKind string
+
+const (
+	ActionPlayCard  ActionKind = "play"
+	ActionAttack    ActionKind = "attack"
+	ActionHeroPower ActionKind = "power"
+	ActionEndTurn   ActionKind = "end"
+	ActionConcede   ActionKind = "concede"
+	// ActionAgreeDraw is every player still in agreeing to end the game in a draw
+	ActionAgreeDraw ActionKind = "agree draw"
+	// ActionDisconnect is a player who left the game forfeiting it
+	ActionDisconnect ActionKind = "disconnect"
+)
+
+// Action is something a player does on their turn
+type Action struct {
+	Kind ActionKind
+	// Player is the ID of whoever is acting
+	Player string
+	// Card is the hand index of the card played, or the board index of the attacking minion
+	Card int
+	// Target is the ID of the opponent aimed at, it can be left out with a single opponent
+	Target string
+}
+
+func PlayCard(player string, card int, target string) Action {
+	return Action{Kind: ActionPlayCard, Player: player, Card: card, Target: target}
+}
+
+// Attack is with the minion at index minion on the board
+func Attack(player string, minion int, target string) Action {
+	return Action{Kind: ActionAttack, Player: player, Card: minion, Target: target}
+}
+
+func HeroPower(player string, target string) Action {
+	return Action{Kind: ActionHeroPower, Player: player, Target: target}
+}
+
+func EndTurn(player string) Action {
+	return Action{Kind: ActionEndTurn, Player: player}
+}
+
+func Concede(player string) Action {
+	return Action{Kind: ActionConcede, Player: player}
+}
+
+func AgreeDraw(player string) Action {
+	return Action{Kind: ActionAgreeDraw, Player: player}
+}
+
+func Disconnect(player string) Action {
+	return Action{Kind: ActionDisconnect, Player: player}
+}
+
+func (a Action) String() string {
+	switch a.Kind {
+	case ActionPlayCard:
+		if a.Target != "" {
+			return fmt.Sprintf("%s: play %d at %s", a.Player, a.Card, a.Target)
+		}
+		return fmt.Sprintf("%s: play %d", a.Player, a.Card)
+	case ActionAttack, ActionHeroPower:
+		if a.Target != "" {
+			return fmt.Sprintf("%s: %s at %s", a.Player, a.Kind, a.Target)
+		}
+	}
+	return fmt.Sprintf("%s: %s", a.Player, a.Kind)
+}
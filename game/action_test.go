@@ -0,0 +1,160 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+// midGame is p1's turn with 3 mana, p2 is a mage
+func midGame() GameState {
+	return GameState{
+		HandSize: 5,
+		Round:    3,
+		Turn:     3,
+		Players: []PlayerState{
+			{ID: "p1", Team: 0, Health: 10, Mana: 3, Hand: []int{2, 5, 1}, Deck: []int{4}, Power: &PowerState{Name: "Armor Up", Cost: 2, Armor: 2}},
+			{ID: "p2", Team: 1, Health: 3, Armor: 1, Hand: []int{}, Deck: []int{}, Power: &PowerState{Name: "Fireblast", Cost: 2, Targeted: true, Damage: 1}},
+		},
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name       string
+		action     Action
+		check      func(t *testing.T, s GameState)
+		wantEvents []EventKind
+		wantErr    error
+	}{
+		{
+			name:   "play a card",
+			action: PlayCard("p1", 0, ""),
+			check: func(t *testing.T, s GameState) {
+				assert.Equal(t, 1, s.Players[0].Mana)
+				assert.Equal(t, []int{5, 1}, s.Players[0].Hand)
+				assert.Equal(t, 0, s.Players[1].Armor)
+				assert.Equal(t, 2, s.Players[1].Health)
+			},
+			wantEvents: []EventKind{EventCardPlayed},
+		},
+		{
+			name:   "lethal",
+			action: PlayCard("p1", 0, "p2"),
+			check: func(t *testing.T, s GameState) {
+				assert.Nil(t, s.Result, "armor soaks one")
+			},
+			wantEvents: []EventKind{EventCardPlayed},
+		},
+		{name: "too expensive", action: PlayCard("p1", 1, ""), wantErr: errs.ErrNotEnoughMana},
+		{name: "negative index", action: PlayCard("p1", -2, ""), wantErr: errs.ErrInvalidIndex},
+		{name: "unknown target", action: PlayCard("p1", 0, "p3"), wantErr: errs.ErrIllegalAction},
+		{name: "not your turn", action: EndTurn("p2"), wantErr: errs.ErrNotYourTurn},
+		{name: "no minions", action: Attack("p1", 0, ""), wantErr: errs.ErrIllegalAction},
+		{
+			name:   "hero power",
+			action: HeroPower("p1", ""),
+			check: func(t *testing.T, s GameState) {
+				assert.Equal(t, 2, s.Players[0].Armor)
+				assert.Equal(t, 1, s.Players[0].Mana)
+				assert.True(t, s.Powered)
+			},
+			wantEvents: []EventKind{EventHeroPower},
+		},
+		{
+			name:   "end turn",
+			action: EndTurn("p1"),
+			check: func(t *testing.T, s GameState) {
+				assert.Equal(t, "p2", s.ActivePlayer().ID)
+				assert.Equal(t, 4, s.Turn)
+				assert.Equal(t, 4, s.Players[1].Mana)
+				assert.Equal(t, 0, s.Players[1].Armor, "burned through armor")
+				assert.Equal(t, 3, s.Players[1].Health)
+			},
+			wantEvents: []EventKind{EventTurnStart, EventBurn},
+		},
+		{
+			name:   "concede",
+			action: Concede("p1"),
+			check: func(t *testing.T, s GameState) {
+				assert.Equal(t, &Result{Winners: []string{"p2"}, Losers: []string{"p1"}, Reason: ReasonConcede}, s.Result)
+			},
+			wantEvents: []EventKind{EventConcede},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := midGame()
+			s, events, err := NewRules(NewEventLog()).Apply(before, tt.action)
+			assert.Equal(t, midGame(), before, "the state applied to isn't changed")
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+				assert.Equal(t, before, s)
+				return
+			}
+			assert.NoError(t, err)
+			kinds := []EventKind{}
+			for _, e := range events {
+				kinds = append(kinds, e.Kind)
+			}
+			assert.Equal(t, tt.wantEvents, kinds)
+			tt.check(t, s)
+		})
+	}
+}
+
+func TestApply_PowerOncePerTurn(t *testing.T) {
+	s := midGame()
+	s.Players[0].Mana = 10
+	rules := NewRules(NewEventLog())
+	s, _, err := rules.Apply(s, HeroPower("p1", ""))
+	assert.NoError(t, err)
+	_, _, err = rules.Apply(s, HeroPower("p1", ""))
+	assert.True(t, errors.Is(err, errs.ErrIllegalAction))
+}
+
+func TestApply_GameOver(t *testing.T) {
+	s := midGame()
+	s.Players[0].Hand = []int{3}
+	s.Players[1].Armor = 0
+	rules := NewRules(NewEventLog())
+	s, _, err := rules.Apply(s, PlayCard("p1", 0, ""))
+	assert.NoError(t, err)
+	assert.Equal(t, &Result{Winners: []string{"p1"}, Losers: []string{"p2"}, Reason: ReasonKilled}, s.Result)
+	_, _, err = rules.Apply(s, EndTurn("p1"))
+	assert.True(t, errors.Is(err, errs.ErrIllegalAction))
+	assert.Nil(t, rules.LegalActions(s))
+}
+
+func TestLegalActions(t *testing.T) {
+	rules := NewRules(NewEventLog())
+	s := midGame()
+	assert.Equal(t, []Action{
+		PlayCard("p1", 0, "p2"),
+		PlayCard("p1", 2, "p2"),
+		HeroPower("p1", ""),
+		EndTurn("p1"),
+		Concede("p1"),
+	}, rules.LegalActions(s))
+
+	s.Active = 1
+	s.Players[1].Mana = 2
+	assert.Equal(t, []Action{HeroPower("p2", "p1"), EndTurn("p2"), Concede("p2")}, rules.LegalActions(s))
+}
+
+func TestLegalActions_AllApply(t *testing.T) {
+	rules := NewRules(NewEventLog())
+	s := midGame()
+	for _, a := range rules.LegalActions(s) {
+		_, _, err := rules.Apply(s, a)
+		assert.NoError(t, err, a.String())
+	}
+}
+
+func TestAction_String(t *testing.T) {
+	assert.Equal(t, "p1: play 2 at p2", PlayCard("p1", 2, "p2").String())
+	assert.Equal(t, "p1: power", HeroPower("p1", "").String())
+	assert.Equal(t, "p1: end", EndTurn("p1").String())
+}
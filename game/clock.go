@@ -0,0 +1,132 @@
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// endTurn is the input that ends the active player's turn
+const endTurn = "-1"
+
+// Clock limits how long a player may take over a turn. Once the per turn time runs out the player's
+// reserve bank is spent, when both are gone the turn is ended for them.
+type Clock struct {
+	perTurn time.Duration
+	bank    time.Duration
+	warn    time.Duration
+	banks   map[string]time.Duration
+	log     *EventLog
+	now     func() time.Time
+	after   func(time.Duration) <-chan time.Time
+	// pending are reads still waiting on input by player ID, a read is kept for the player's next turn
+	// so two reads of their input never race and nobody else waits on it
+	pending map[string]chan string
+	// stale is set for a player whose pending read was started by a turn that ran out of time, what it
+	// reads is dropped
+	stale map[string]bool
+}
+
+func NewClock(perTurn, bank, warn time.Duration, log *EventLog) *Clock {
+	return &Clock{
+		perTurn: perTurn,
+		bank:    bank,
+		warn:    warn,
+		banks:   map[string]time.Duration{},
+		pending: map[string]chan string{},
+		stale:   map[string]bool{},
+		log:     log,
+		now:     time.Now,
+		after:   time.After,
+	}
+}
+
+// Bank returns the reserve time a player has left
+func (c *Clock) Bank(id string) time.Duration {
+	b, ok := c.banks[id]
+	if !ok {
+		return c.bank
+	}
+	return b
+}
+
+// Wrap times every turn played through turn
+func (c *Clock) Wrap(turn TurnFunc) TurnFunc {
+	if c.perTurn <= 0 {
+		return turn
+	}
+	return func(iter int, active Player, table *Table, getInput func() string) *Result {
+		id := active.ID()
+		start := c.now()
+		bank := c.Bank(id)
+		input := c.timedInput(iter, id, start.Add(c.perTurn), start.Add(c.perTurn+bank), getInput)
+		result := turn(iter, active, table, input)
+		if used := c.now().Sub(start) - c.perTurn; used > 0 {
+			bank -= used
+			if bank < 0 {
+				bank = 0
+			}
+		}
+		c.banks[id] = bank
+		return result
+	}
+}
+
+func (c *Clock) timedInput(iter int, id string, turnEnd, deadline time.Time, getInput func() string) func() string {
+	expired := false
+	warned := false
+	banking := false
+	return func() string {
+		if expired {
+			return endTurn
+		}
+		if c.pending[id] == nil {
+			c.read(id, getInput)
+		}
+		for {
+			next := deadline
+			if w := deadline.Add(-c.warn); !warned && c.warn > 0 && w.Before(next) {
+				next = w
+			}
+			if !banking && turnEnd.Before(next) {
+				next = turnEnd
+			}
+			select {
+			case s := <-c.pending[id]:
+				delete(c.pending, id)
+				if !c.stale[id] || s == Disconnected {
+					return s
+				}
+				delete(c.stale, id)
+				fmt.Fprintf(out, "\nIgnoring %q, it was typed after %s's last turn ran out of time\n", s, id)
+				c.read(id, getInput)
+				continue
+			case <-c.after(next.Sub(c.now())):
+			}
+			now := c.now()
+			if !now.Before(deadline) {
+				expired = true
+				c.stale[id] = true
+				fmt.Fprintf(out, "\n%s ran out of time, ending turn\n", id)
+				c.log.Record(Event{Turn: iter, Player: id, Kind: EventTimeout})
+				return endTurn
+			}
+			if !banking && !now.Before(turnEnd) {
+				banking = true
+				fmt.Fprintf(out, "\n%s is now using their time bank\n", id)
+			}
+			if !warned && c.warn > 0 && deadline.Sub(now) <= c.warn {
+				warned = true
+				fmt.Fprintf(out, "\n%s has %d seconds left!\n", id, int(deadline.Sub(now).Seconds()+0.5))
+			}
+		}
+	}
+}
+
+// read starts reading a player's next input into pending
+func (c *Clock) read(id string, getInput func() string) {
+	ch := make(chan string, 1)
+	c.pending[id] = ch
+	go func() {
+		ch <- getInput()
+	}()
+}
@@ -0,0 +1,144 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// inputTurn is a turn that reads a single input and records it
+type inputTurn struct {
+	got []string
+}
+
+func (t *inputTurn) turn(iter int, active Player, table *Table, getInput func() string) *Result {
+	t.got = append(t.got, getInput())
+	return nil
+}
+
+func TestClock_Wrap(t *testing.T) {
+	never := func() string {
+		select {}
+	}
+	tests := []struct {
+		name       string
+		perTurn    time.Duration
+		bank       time.Duration
+		warn       time.Duration
+		input      func() string
+		wantInput  string
+		wantEvents []Event
+		wantBank   time.Duration
+	}{
+		{
+			name:       "input in time",
+			perTurn:    time.Second,
+			bank:       time.Second,
+			input:      func() string { return "2" },
+			wantInput:  "2",
+			wantEvents: []Event{},
+			wantBank:   time.Second,
+		},
+		{
+			name:       "out of time ends turn",
+			perTurn:    10 * time.Millisecond,
+			warn:       5 * time.Millisecond,
+			input:      never,
+			wantInput:  endTurn,
+			wantEvents: []Event{{Turn: 1, Player: "p1", Kind: EventTimeout}},
+		},
+		{
+			name:       "bank is spent before the turn ends",
+			perTurn:    10 * time.Millisecond,
+			bank:       20 * time.Millisecond,
+			input:      never,
+			wantInput:  endTurn,
+			wantEvents: []Event{{Turn: 1, Player: "p1", Kind: EventTimeout}},
+			wantBank:   0,
+		},
+		{
+			name:      "disabled clock",
+			perTurn:   0,
+			bank:      time.Second,
+			input:     func() string { return "0" },
+			wantInput: "0",
+			wantBank:  time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := NewEventLog()
+			c := NewClock(tt.perTurn, tt.bank, tt.warn, log)
+			active := &mockPlayer{}
+			active.On("ID").Return("p1")
+			turner := &inputTurn{}
+			c.Wrap(turner.turn)(1, active, nil, tt.input)
+			assert.Equal(t, []string{tt.wantInput}, turner.got)
+			if tt.wantEvents != nil {
+				assert.Equal(t, tt.wantEvents, log.Events())
+			}
+			assert.Equal(t, tt.wantBank, c.Bank("p1"))
+		})
+	}
+}
+
+func TestClock_DropsInputAfterTimeout(t *testing.T) {
+	c := NewClock(10*time.Millisecond, 0, 0, NewEventLog())
+	release := make(chan string)
+	calls := 0
+	input := func() string {
+		calls++
+		return <-release
+	}
+	p1 := &mockPlayer{}
+	p1.On("ID").Return("p1")
+	turner := &inputTurn{}
+	turn := c.Wrap(turner.turn)
+
+	turn(1, p1, nil, input)
+	go func() {
+		release <- "3"
+		release <- "4"
+	}()
+	turn(3, p1, nil, input)
+
+	assert.Equal(t, []string{endTurn, "4"}, turner.got, "p1's late input isn't played on their next turn")
+	assert.Equal(t, 2, calls, "the timed out read finishes before another starts")
+}
+
+func TestClock_TimeoutThenNextTurn(t *testing.T) {
+	c := NewClock(10*time.Millisecond, 0, 0, NewEventLog())
+	late := make(chan string)
+	p1 := &mockPlayer{}
+	p1.On("ID").Return("p1")
+	p2 := &mockPlayer{}
+	p2.On("ID").Return("p2")
+	turner := &inputTurn{}
+	turn := c.Wrap(turner.turn)
+
+	turn(1, p1, nil, func() string { return <-late })
+	turn(2, p2, nil, func() string { return "4" })
+	late <- "3"
+	turn(3, p1, nil, func() string { return <-late })
+
+	assert.Equal(t, []string{endTurn, "4", endTurn}, turner.got, "p2 isn't held up by p1's read, and it only ever answers p1")
+}
+
+func TestClock_DisconnectAfterTimeout(t *testing.T) {
+	c := NewClock(10*time.Millisecond, 0, 0, NewEventLog())
+	release := make(chan string, 1)
+	input := func() string {
+		return <-release
+	}
+	p1 := &mockPlayer{}
+	p1.On("ID").Return("p1")
+	turner := &inputTurn{}
+	turn := c.Wrap(turner.turn)
+
+	turn(1, p1, nil, input)
+	release <- Disconnected
+	turn(2, p1, nil, input)
+
+	assert.Equal(t, []string{endTurn, Disconnected}, turner.got, "a disconnect is never dropped")
+}
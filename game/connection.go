@@ -0,0 +1,31 @@
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// Disconnected is returned by an input source once its player has gone away
+const Disconnected = "\x04"
+
+// WithGrace gives a disconnected player grace to come back, polling every retry,
+// before Disconnected is passed on and the player forfeits
+func WithGrace(getInput func() string, grace, retry time.Duration) func() string {
+	return func() string {
+		var gone time.Time
+		for {
+			s := getInput()
+			if s != Disconnected {
+				return s
+			}
+			if gone.IsZero() {
+				gone = time.Now()
+				fmt.Fprintf(out, "Player disconnected, waiting %v for them to come back\n", grace)
+			}
+			if time.Since(gone) >= grace {
+				return Disconnected
+			}
+			time.Sleep(retry)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithGrace(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		grace time.Duration
+		want  string
+	}{
+		{
+			name:  "connected",
+			input: []string{"1"},
+			grace: time.Second,
+			want:  "1",
+		},
+		{
+			name:  "reconnects within grace",
+			input: []string{Disconnected, Disconnected, "2"},
+			grace: time.Second,
+			want:  "2",
+		},
+		{
+			name:  "gone for longer than grace",
+			input: []string{Disconnected, Disconnected, Disconnected, Disconnected, Disconnected},
+			grace: 2 * time.Millisecond,
+			want:  Disconnected,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &mockUserInput{input: tt.input}
+			if got := WithGrace(u.get, tt.grace, time.Millisecond)(); got != tt.want {
+				t.Errorf("WithGrace() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package game
+
+import (
+	"fmt"
+)
+
+// Effect is what a card does instead of dealing its value in damage
+type Effect struct {
+	Name string
+	// Targeted effects are aimed at an opponent, the others are cast with a nil target
+	Targeted bool
+	// Cast happens when the card is played, it returns the damage the target takes and what happened
+	Cast func(caster, target Player) (int, string)
+	// Drawn happens when the card is drawn, the card is discarded instead of going to hand
+	Drawn func(drawer Player) string
+}
+
+// Holder is a player whose hand and deck card effects can change
+type Holder interface {
+	ShowHand() []int
+	AddToHand(card int)
+	ShuffleIntoDeck(card int)
+	Transform(index, card int) error
+	Discard(index int) error
+}
+
+// HolderOf finds the hand and deck of p or of a player it wraps
+func HolderOf(p Player) (Holder, bool) {
+	for {
+		if h, ok := p.(Holder); ok {
+			return h, true
+		}
+		w, ok := p.(Wrapper)
+		if !ok {
+			return nil, false
+		}
+		p = w.Unwrap()
+	}
+}
+
+// cast plays an effect card, it returns the damage the target takes
+func (r *Rules) cast(iter int, caster, target Player, e Effect) int {
+	damage, text := 0, "does nothing"
+	if e.Cast != nil {
+		damage, text = e.Cast(caster, target)
+	}
+	fmt.Fprintf(r.output(), "%s casts %s: %s\n", caster.ID(), e.Name, text)
+	r.record(iter, caster, EventEffect, fmt.Sprintf("%s, %s", e.Name, text))
+	return damage
+}
+
+// draw draws active's card for the turn and sets it off if it does something when drawn, it reports
+// whether it did
+func (r *Rules) draw(iter int, active Player) (bool, error) {
+	h, ok := HolderOf(active)
+	if !ok {
+		return false, active.Draw()
+	}
+	if err := active.Draw(); err != nil {
+		return false, err
+	}
+	hand := h.ShowHand()
+	last := len(hand) - 1
+	e, ok := r.ruleset.Effects[hand[last]]
+	if !ok || e.Drawn == nil {
+		return false, nil
+	}
+	h.Discard(last)
+	text := e.Drawn(active)
+	fmt.Fprintf(r.output(), "%s draws %s: %s\n", active.ID(), e.Name, text)
+	r.record(iter, active, EventEffect, fmt.Sprintf("%s, %s", e.Name, text))
+	return true, nil
+}
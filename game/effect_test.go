@@ -0,0 +1,174 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+// holdingPlayer draws from the top of a real deck
+type holdingPlayer struct {
+	cardPlayer
+	deck []int
+}
+
+func (p *holdingPlayer) Draw() error {
+	if len(p.deck) == 0 {
+		return errs.ErrDeckEmpty
+	}
+	card := p.deck[0]
+	p.deck = p.deck[1:]
+	if len(p.hand) == 5 {
+		return errs.ErrHandFull
+	}
+	p.hand = append(p.hand, card)
+	return nil
+}
+func (p *holdingPlayer) AddToHand(card int)       { p.hand = append(p.hand, card) }
+func (p *holdingPlayer) ShuffleIntoDeck(card int) { p.deck = append(p.deck, card) }
+func (p *holdingPlayer) Transform(i, card int) error {
+	p.hand[i] = card
+	return nil
+}
+func (p *holdingPlayer) Discard(i int) error {
+	p.hand = append(p.hand[:i], p.hand[i+1:]...)
+	return nil
+}
+
+var testEffects = map[int]Effect{
+	20: {Name: "Gift", Cast: func(caster, target Player) (int, string) {
+		h, _ := HolderOf(caster)
+		h.AddToHand(1)
+		return 0, "adds a card"
+	}},
+	21: {Name: "Bolt", Targeted: true, Cast: func(caster, target Player) (int, string) {
+		return 4, "rolls 4"
+	}},
+	22: {Name: "Bomb", Drawn: func(drawer Player) string {
+		drawer.ApplyDamage(3)
+		return "explodes"
+	}},
+}
+
+func effectRules() *Rules {
+	ruleset := DefaultRuleset()
+	ruleset.Secrets = testSecrets
+	ruleset.Effects = testEffects
+	return NewRulesWith(NewEventLog(), ruleset)
+}
+
+func TestRules_PlayCastsEffect(t *testing.T) {
+	p1 := &holdingPlayer{cardPlayer: cardPlayer{id: "p1", health: 10, hand: []int{20}}, deck: []int{2}}
+	p2 := &cardPlayer{id: "p2", health: 10}
+	p3 := &cardPlayer{id: "p3", health: 10}
+	r := effectRules()
+	u := &script{actions: []Action{PlayCard("p1", 0, ""), EndTurn("p1")}}
+
+	result := r.Play(1, &wrapped{p1}, NewTable([]Player{p1, p2, p3}, nil), u.next)
+
+	assert.Nil(t, result)
+	assert.Equal(t, []int{2, 1}, p1.hand, "an untargeted effect needs no target even with two opponents")
+	assert.Equal(t, Event{Turn: 1, Player: "p1", Kind: EventEffect, Detail: "Gift, adds a card"}, r.log.Events()[1])
+}
+
+func TestRules_PlayCastsTargetedEffect(t *testing.T) {
+	tests := []struct {
+		name       string
+		secret     int
+		wantHealth int
+		wantCast   bool
+	}{
+		{name: "damage", wantHealth: 6, wantCast: true},
+		{name: "countered", secret: 11, wantHealth: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1 := &cardPlayer{id: "p1", health: 10, hand: []int{21}}
+			p2 := &cardPlayer{id: "p2", health: 10}
+			r := effectRules()
+			if tt.secret != 0 {
+				r.arm(0, p2, tt.secret)
+			}
+			u := &script{actions: []Action{PlayCard("p1", 0, ""), EndTurn("p1")}}
+
+			r.Play(1, p1, NewTable([]Player{p1, p2}, nil), u.next)
+
+			assert.Equal(t, tt.wantHealth, p2.health)
+			cast := false
+			for _, e := range r.log.Events() {
+				cast = cast || e.Kind == EventEffect
+			}
+			assert.Equal(t, tt.wantCast, cast, "a countered effect is never cast")
+		})
+	}
+}
+
+func TestRules_PlayCastsUntargetedEffectIntoSecrets(t *testing.T) {
+	tests := []struct {
+		name     string
+		secrets  map[string][]int
+		wantHand []int
+		wantLeft map[string]int
+	}{
+		{name: "no secrets", wantHand: []int{1}, wantLeft: map[string]int{"p2": 0, "p3": 0}},
+		{name: "countered by either opponent", secrets: map[string][]int{"p3": {11}}, wantHand: []int{}, wantLeft: map[string]int{"p2": 0, "p3": 0}},
+		{name: "one counter is enough", secrets: map[string][]int{"p2": {11}, "p3": {11}}, wantHand: []int{}, wantLeft: map[string]int{"p2": 0, "p3": 1}},
+		{name: "other triggers wait", secrets: map[string][]int{"p2": {13}}, wantHand: []int{1}, wantLeft: map[string]int{"p2": 1, "p3": 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1 := &holdingPlayer{cardPlayer: cardPlayer{id: "p1", health: 10, hand: []int{20}}}
+			p2 := &cardPlayer{id: "p2", health: 10}
+			p3 := &cardPlayer{id: "p3", health: 10}
+			r := effectRules()
+			for id, cards := range tt.secrets {
+				r.secrets[id] = cards
+			}
+			u := &script{actions: []Action{PlayCard("p1", 0, ""), EndTurn("p1")}}
+
+			r.Play(1, p1, NewTable([]Player{p1, p2, p3}, nil), u.next)
+
+			assert.Equal(t, tt.wantHand, p1.hand)
+			assert.Equal(t, tt.wantLeft, map[string]int{"p2": r.Secrets(p2), "p3": r.Secrets(p3)})
+		})
+	}
+}
+
+func TestRules_PlayDrawsEffect(t *testing.T) {
+	tests := []struct {
+		name       string
+		hand       []int
+		health     int
+		wantHand   []int
+		wantHealth int
+		wantResult bool
+	}{
+		{name: "explodes", hand: []int{5}, health: 10, wantHand: []int{5}, wantHealth: 7},
+		{name: "kills", hand: []int{}, health: 3, wantHand: []int{}, wantHealth: 0, wantResult: true},
+		{name: "burned in a full hand", hand: []int{1, 2, 3, 4, 5}, health: 10, wantHand: []int{1, 2, 3, 4, 5}, wantHealth: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1 := &holdingPlayer{cardPlayer: cardPlayer{id: "p1", health: tt.health, hand: tt.hand}, deck: []int{22}}
+			p2 := &cardPlayer{id: "p2", health: 10}
+			r := effectRules()
+			u := &script{actions: []Action{EndTurn("p1")}}
+
+			result := r.Play(1, p1, NewTable([]Player{p1, p2}, nil), u.next)
+
+			assert.Equal(t, tt.wantResult, result != nil)
+			assert.Equal(t, tt.wantHand, p1.hand)
+			assert.Equal(t, tt.wantHealth, p1.health)
+		})
+	}
+}
+
+func TestHolderOf(t *testing.T) {
+	p := &holdingPlayer{cardPlayer: cardPlayer{id: "p1"}}
+	h, ok := HolderOf(&wrapped{&wrapped{p}})
+	assert.True(t, ok)
+	assert.True(t, h == p)
+	_, ok = HolderOf(&cardPlayer{id: "p2"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,53 @@
+package game
+
+import (
+	"fmt"
+)
+
+type EventKind string
+
+const (
+	EventTimeout    EventKind = "timeout"
+	EventTurnStart  EventKind = "turn start"
+	EventBurn       EventKind = "burn"
+	EventCardPlayed EventKind = "card played"
+	EventHeroPower  EventKind = "hero power"
+	// EventSecretPlayed doesn't say which secret, only that there is one
+	EventSecretPlayed   EventKind = "secret played"
+	EventSecretRevealed EventKind = "secret revealed"
+	EventEffect         EventKind = "effect"
+	EventConcede        EventKind = "concede"
+)
+
+// Event is something notable that happened during a game
+type Event struct {
+	Turn   int
+	Player string
+	Kind   EventKind
+	Detail string
+}
+
+func (e Event) String() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("turn %d %s: %s", e.Turn, e.Player, e.Kind)
+	}
+	return fmt.Sprintf("turn %d %s: %s, %s", e.Turn, e.Player, e.Kind, e.Detail)
+}
+
+type EventLog struct {
+	events []Event
+}
+
+func NewEventLog() *EventLog {
+	return &EventLog{
+		events: []Event{},
+	}
+}
+
+func (l *EventLog) Record(e Event) {
+	l.events = append(l.events, e)
+}
+
+func (l *EventLog) Events() []Event {
+	return l.events
+}
@@ -0,0 +1,40 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventLog_Record(t *testing.T) {
+	l := NewEventLog()
+	e := Event{Turn: 2, Player: "p1", Kind: EventTimeout}
+	l.Record(e)
+	assert.Equal(t, []Event{e}, l.Events())
+}
+
+func TestEvent_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		event Event
+		want  string
+	}{
+		{
+			name:  "with detail",
+			event: Event{Turn: 2, Player: "p1", Kind: EventCardPlayed, Detail: "3 damage to p2"},
+			want:  "turn 2 p1: card played, 3 damage to p2",
+		},
+		{
+			name:  "without detail",
+			event: Event{Turn: 4, Player: "p2", Kind: EventTimeout},
+			want:  "turn 4 p2: timeout",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.event.String(); got != tt.want {
+				t.Errorf("Event.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package game
 
 import (
+	"errors"
 	"fmt"
-	"strconv"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
 )
 
 type Player interface {
@@ -16,84 +18,134 @@ type Player interface {
 	PrintStats()
 }
 
+// HeroPowered is a player whose class gives them a hero power
+type HeroPowered interface {
+	// PowerTargeted is whether the power is aimed at an opponent
+	PowerTargeted() bool
+	// CanUsePower says why the power can't be paid for right now, nil when it can
+	CanUsePower() error
+	// UsePower pays for and uses the power, target is nil when it isn't targeted. It describes what
+	// happened for the log.
+	UsePower(target Player) (string, error)
+}
+
+// Wrapper is a player decorating another one, like a UI showing the player's moves
+type Wrapper interface {
+	Unwrap() Player
+}
+
+// heroPower finds the hero power of p or of a player it wraps
+func heroPower(p Player) (HeroPowered, bool) {
+	for {
+		if hp, ok := p.(HeroPowered); ok {
+			return hp, true
+		}
+		w, ok := p.(Wrapper)
+		if !ok {
+			return nil, false
+		}
+		p = w.Unwrap()
+	}
+}
+
+type Reason string
+
+const (
+	ReasonKilled            Reason = "killed"
+	ReasonConcede           Reason = "concede"
+	ReasonDrawAgreed        Reason = "draw agreed"
+	ReasonDisconnect        Reason = "disconnect"
+	ReasonSimultaneousDeath Reason = "simultaneous death"
+)
+
+// Result is the outcome of a finished game. Winners are everyone on the winning team, when Draw
+// is set they are everyone on the teams that tied. Losers are all the other players.
+type Result struct {
+	Winners []string
+	Losers  []string
+	Draw    bool
+	Reason  Reason
+}
+
+// TurnFunc plays a single turn, it returns nil unless the game is over
+type TurnFunc func(iter int, active Player, table *Table, getInput func() string) *Result
+
 type Game struct {
-	p1        Player
-	p2        Player
+	table     *Table
 	userInput func() string
-	turn      func(iter int, active, passive Player, getInput func() string) bool
+	turn      TurnFunc
+}
+
+func NewGame(p1, p2 Player, userInput func() string, turn TurnFunc) *Game {
+	return NewFreeForAll([]Player{p1, p2}, userInput, turn)
 }
 
-func NewGame(p1, p2 Player, userInput func() string, turn func(iter int, active, passive Player, getInput func() string) bool) *Game {
+// NewFreeForAll is every player for themselves, turns go in the order players are given
+func NewFreeForAll(players []Player, userInput func() string, turn TurnFunc) *Game {
 	return &Game{
-		p1:        p1,
-		p2:        p2,
+		table:     NewTable(players, nil),
 		userInput: userInput,
 		turn:      turn,
 	}
 }
 
-func (g *Game) Start() {
-	fmt.Println("GAME START")
-	for i := 0; i < 3; i++ {
-		if err := g.p1.Draw(); err != nil {
-			fmt.Println("Cannot start game with inadequate sized deck")
-			return
-		}
-
-		if err := g.p2.Draw(); err != nil {
-			fmt.Println("Cannot start game with inadequate sized deck")
-			return
+// NewTeamGame seats the teams alternately, so the first player of every team goes before the seconds
+func NewTeamGame(teams [][]Player, userInput func() string, turn TurnFunc) *Game {
+	players := []Player{}
+	seats := []int{}
+	for seat := 0; ; seat++ {
+		seated := false
+		for team, members := range teams {
+			if seat < len(members) {
+				players = append(players, members[seat])
+				seats = append(seats, team)
+				seated = true
+			}
 		}
-	}
-	count := 0
-	active := g.p1
-	passive := g.p2
-	for {
-		count++ // does this increase once both players have gone?
-		over := g.turn(count, active, passive, g.userInput)
-		if over {
+		if !seated {
 			break
 		}
-		t := active
-		active = passive
-		passive = t
 	}
-	fmt.Println("GAME OVER")
+	return &Game{
+		table:     NewTable(players, seats),
+		userInput: userInput,
+		turn:      turn,
+	}
 }
 
-func Turn(iter int, active, passive Player, getInput func() string) bool {
-	fmt.Printf("%s's turn!\n", active.ID())
-	active.SetMana(min(iter, 10))
-	err := active.Draw()
-	if err != nil {
-		fmt.Println("You tried to draw with no cards in your deck! Applying burn damage")
-		active.ApplyDamage(1) // no deck
+// Start plays until one team is left, the result is nil if the game couldn't start
+func (g *Game) Start() *Result {
+	fmt.Fprintln(out, "GAME START")
+	if err := deal(g.table.Players()); err != nil {
+		fmt.Fprintln(out, "Cannot start game with inadequate sized deck")
+		return nil
 	}
-	for {
-		fmt.Println(active.ID(), "health:", active.GetHealth(), passive.ID(), "health:", passive.GetHealth())
-		active.PrintStats()
-		s := getInput()
-		i, err := strconv.Atoi(s)
-		if err != nil {
-			fmt.Println("Invalid integer")
-			continue
-		}
-		if i == -1 {
-			break
-		}
-		damage, err := active.PlayCard(i)
-		if err != nil {
-			fmt.Println(err)
-			continue
+	count := 0
+	for round := 1; ; round++ {
+		g.table.round = round
+		for _, active := range g.table.Players() {
+			if g.table.IsOut(active) {
+				continue
+			}
+			count++ // does this increase once both players have gone?
+			if result := g.turn(count, active, g.table, g.userInput); result != nil {
+				fmt.Fprintln(out, "GAME OVER")
+				return result
+			}
 		}
-		passive.ApplyDamage(damage)
-		if passive.IsDead() {
-			fmt.Println(passive.ID(), "Is Dead!")
-			fmt.Println(active.ID(), "WINS!")
-			return true
+	}
+}
+
+// deal draws every player their opening hand
+func deal(players []Player) error {
+	for i := 0; i < 3; i++ {
+		for _, p := range players {
+			if err := p.Draw(); errors.Is(err, errs.ErrDeckEmpty) {
+				return fmt.Errorf("%w, %s can't be dealt an opening hand", errs.ErrDeckEmpty, p.ID())
+			}
 		}
 	}
-	return false
+	return nil
 }
 
 func min(i, j int) int {
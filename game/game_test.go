@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/ShookieShookie/WorkshopImpl/errs"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -48,9 +49,9 @@ type mockTurner struct {
 	mock.Mock
 }
 
-func (m *mockTurner) turn(iter int, active, passive Player, getInput func() string) bool {
-	args := m.Called(iter, active, passive, getInput)
-	return args.Bool(0)
+func (m *mockTurner) turn(iter int, active Player, table *Table, getInput func() string) *Result {
+	args := m.Called(iter, active, table, getInput)
+	return args.Get(0).(*Result)
 }
 
 func TestGame_Start(t *testing.T) {
@@ -62,7 +63,7 @@ func TestGame_Start(t *testing.T) {
 		active   Player
 		passive  Player
 		getInput func()
-		over     bool
+		result   *Result
 	}
 	type mockDrawArgs struct {
 		err error
@@ -73,28 +74,31 @@ func TestGame_Start(t *testing.T) {
 		p1DrawArgs *mockDrawArgs
 		p2DrawArgs *mockDrawArgs
 		turnArgs   []turnArgs
+		want       *Result
 	}{
 		{
 			name:       "multiple turns switch players",
 			p1DrawArgs: &mockDrawArgs{err: nil},
 			p2DrawArgs: &mockDrawArgs{err: nil},
-			turnArgs:   []turnArgs{{iter: 1, getInput: nil}, {iter: 2, getInput: nil, over: true}},
+			turnArgs:   []turnArgs{{iter: 1, getInput: nil}, {iter: 2, getInput: nil, result: &Result{Winners: []string{"p2"}, Losers: []string{"p1"}, Reason: ReasonKilled}}},
 			fields: fields{
 				userInput: nil,
 			},
+			want: &Result{Winners: []string{"p2"}, Losers: []string{"p1"}, Reason: ReasonKilled},
 		},
 		{
 			name:       "happy",
 			p1DrawArgs: &mockDrawArgs{err: nil},
 			p2DrawArgs: &mockDrawArgs{err: nil},
-			turnArgs:   []turnArgs{{iter: 1, getInput: nil, over: true}},
+			turnArgs:   []turnArgs{{iter: 1, getInput: nil, result: &Result{Winners: []string{"p1"}, Losers: []string{"p2"}, Reason: ReasonKilled}}},
 			fields: fields{
 				userInput: nil,
 			},
+			want: &Result{Winners: []string{"p1"}, Losers: []string{"p2"}, Reason: ReasonKilled},
 		},
 		{
 			name:       "p1 draw fail",
-			p1DrawArgs: &mockDrawArgs{err: errors.New("empty deck")},
+			p1DrawArgs: &mockDrawArgs{err: errs.ErrDeckEmpty},
 			p2DrawArgs: nil,
 			fields: fields{
 				userInput: nil,
@@ -103,7 +107,7 @@ func TestGame_Start(t *testing.T) {
 		{
 			name:       "p2 draw fail",
 			p1DrawArgs: &mockDrawArgs{err: nil},
-			p2DrawArgs: &mockDrawArgs{err: errors.New("empty deck")},
+			p2DrawArgs: &mockDrawArgs{err: errs.ErrDeckEmpty},
 			fields: fields{
 				userInput: nil,
 			},
@@ -112,7 +116,9 @@ func TestGame_Start(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p1 := mockPlayer{}
+			p1.On("ID").Return("p1")
 			p2 := mockPlayer{}
+			p2.On("ID").Return("p2")
 			if tt.p1DrawArgs != nil {
 				p1.On("Draw").Return(tt.p1DrawArgs.err)
 			}
@@ -122,25 +128,19 @@ func TestGame_Start(t *testing.T) {
 			turner := &mockTurner{}
 			if tt.turnArgs != nil {
 				for _, a := range tt.turnArgs {
-					var active *mockPlayer
-					var passive *mockPlayer
+					active := &p1
 					if a.iter%2 == 0 {
 						active = &p2
-						passive = &p1
-					} else {
-						active = &p1
-						passive = &p2
 					}
-					turner.On("turn", a.iter, active, passive, mock.Anything).Return(a.over)
+					turner.On("turn", a.iter, active, mock.Anything, mock.Anything).Return(a.result)
 				}
 			}
 			g := &Game{
-				p1:        &p1,
-				p2:        &p2,
+				table:     NewTable([]Player{&p1, &p2}, nil),
 				userInput: tt.fields.userInput,
 				turn:      turner.turn,
 			}
-			g.Start()
+			assert.Equal(t, tt.want, g.Start())
 			p1.AssertExpectations(t)
 			p2.AssertExpectations(t)
 		})
@@ -169,8 +169,6 @@ func TestTurn(t *testing.T) {
 	type ActiveApplyDamageArgs struct {
 		damage int
 	}
-	type ActivePrintStatsArgs struct {
-	}
 	type ActivePlayCardArgs struct {
 		index  []int
 		damage []int
@@ -179,8 +177,8 @@ func TestTurn(t *testing.T) {
 	type ActiveIDArgs struct {
 		ret string
 	}
-	type ActiveGetHealthArgs struct {
-		health int
+	type ActiveIsDeadArgs struct {
+		ret []bool
 	}
 	type PassiveGetHealthArgs struct {
 		health int
@@ -192,12 +190,12 @@ func TestTurn(t *testing.T) {
 		damage int
 	}
 	type PassiveIsDeadArgs struct {
-		ret bool
+		ret []bool
 	}
 
 	type args struct {
-		iter      int
-		userInput []string
+		iter    int
+		actions []Action
 	}
 	tests := []struct {
 		name                   string
@@ -205,95 +203,181 @@ func TestTurn(t *testing.T) {
 		ActiveSetManaArgs      *ActiveSetManaArgs
 		ActiveDrawArgs         *ActiveDrawArgs
 		ActiveApplyDamageArgs  *ActiveApplyDamageArgs
-		ActivePrintStatsArgs   *ActivePrintStatsArgs
 		ActivePlayCardArgs     *ActivePlayCardArgs
 		ActiveIDArgs           *ActiveIDArgs
-		ActiveGetHealthArgs    *ActiveGetHealthArgs
+		ActiveIsDeadArgs       *ActiveIsDeadArgs
 		PassiveGetHealthArgs   *PassiveGetHealthArgs
 		PassiveIDArgs          *PassiveIDArgs
 		PassiveApplyDamageArgs *PassiveApplyDamageArgs
 		PassiveIsDeadArgs      *PassiveIsDeadArgs
-		want                   bool
+		want                   *Result
 	}{
 		{
 			name:                   "round 1 game over",
-			args:                   args{iter: 1, userInput: []string{"0"}},
+			args:                   args{iter: 1, actions: []Action{PlayCard("name1", 0, "")}},
 			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
 			ActiveDrawArgs:         &ActiveDrawArgs{ret: nil},
 			ActiveApplyDamageArgs:  nil,
-			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
 			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{0}, damage: []int{5}, err: []error{nil}},
 			ActiveIDArgs:           &ActiveIDArgs{"name1"},
-			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 1},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{false}},
 			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: -1},
 			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
 			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
-			PassiveIsDeadArgs:      &PassiveIsDeadArgs{true},
-			want:                   true,
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{true}},
+			want:                   &Result{Winners: []string{"name1"}, Losers: []string{"name2"}, Reason: ReasonKilled},
 		},
 		{
 			name:                   "player 1 is out of deck and receives damage",
-			args:                   args{iter: 1, userInput: []string{"0"}},
+			args:                   args{iter: 1, actions: []Action{PlayCard("name1", 0, "")}},
 			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
-			ActiveDrawArgs:         &ActiveDrawArgs{ret: errors.New("out of deck")},
+			ActiveDrawArgs:         &ActiveDrawArgs{ret: errs.ErrDeckEmpty},
 			ActiveApplyDamageArgs:  &ActiveApplyDamageArgs{damage: 1},
-			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
 			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{0}, damage: []int{5}, err: []error{nil}},
 			ActiveIDArgs:           &ActiveIDArgs{"name1"},
-			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 1},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{false, false}},
 			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: -1},
 			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
 			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
-			PassiveIsDeadArgs:      &PassiveIsDeadArgs{true},
-			want:                   true,
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{false, true}},
+			want:                   &Result{Winners: []string{"name1"}, Losers: []string{"name2"}, Reason: ReasonKilled},
 		},
 		{
-			name:                   "bad user input first try",
-			args:                   args{iter: 1, userInput: []string{"asdf", "0"}},
+			name:                   "refused action first try",
+			args:                   args{iter: 1, actions: []Action{Attack("name1", 0, ""), PlayCard("name1", 0, "")}},
 			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
-			ActiveDrawArgs:         &ActiveDrawArgs{ret: errors.New("out of deck")},
+			ActiveDrawArgs:         &ActiveDrawArgs{ret: errs.ErrDeckEmpty},
 			ActiveApplyDamageArgs:  &ActiveApplyDamageArgs{damage: 1},
-			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
 			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{0}, damage: []int{5}, err: []error{nil}},
 			ActiveIDArgs:           &ActiveIDArgs{"name1"},
-			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 1},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{false, false}},
 			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: -1},
 			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
 			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
-			PassiveIsDeadArgs:      &PassiveIsDeadArgs{true},
-			want:                   true,
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{false, true}},
+			want:                   &Result{Winners: []string{"name1"}, Losers: []string{"name2"}, Reason: ReasonKilled},
 		},
 		{
 			name:                   "user doesn't want to play any more cards",
-			args:                   args{iter: 1, userInput: []string{"-1"}},
+			args:                   args{iter: 1, actions: []Action{EndTurn("name1")}},
 			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
 			ActiveDrawArgs:         &ActiveDrawArgs{ret: nil},
 			ActiveApplyDamageArgs:  nil,
-			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
 			ActivePlayCardArgs:     nil,
 			ActiveIDArgs:           &ActiveIDArgs{"name1"},
-			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 1},
-			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: -1},
 			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
 			PassiveApplyDamageArgs: nil,
 			PassiveIsDeadArgs:      nil,
-			want:                   false,
+			want:                   nil,
 		},
 		{
 			name:                   "play card illegal index causes a second turn",
-			args:                   args{iter: 1, userInput: []string{"12435", "1"}},
+			args:                   args{iter: 1, actions: []Action{PlayCard("name1", 12435, ""), PlayCard("name1", 1, "")}},
 			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
 			ActiveDrawArgs:         &ActiveDrawArgs{ret: nil},
 			ActiveApplyDamageArgs:  nil,
-			ActivePrintStatsArgs:   &ActivePrintStatsArgs{},
 			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{12435, 1}, damage: []int{0, 5}, err: []error{errors.New("illegal index"), nil}},
 			ActiveIDArgs:           &ActiveIDArgs{"name1"},
-			ActiveGetHealthArgs:    &ActiveGetHealthArgs{health: 1},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{false}},
 			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: -1},
 			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
 			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
-			PassiveIsDeadArgs:      &PassiveIsDeadArgs{true},
-			want:                   true,
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{true}},
+			want:                   &Result{Winners: []string{"name1"}, Losers: []string{"name2"}, Reason: ReasonKilled},
+		},
+		{
+			name:              "concede",
+			args:              args{iter: 1, actions: []Action{Concede("name1")}},
+			ActiveSetManaArgs: &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:    &ActiveDrawArgs{ret: nil},
+			ActiveIDArgs:      &ActiveIDArgs{"name1"},
+			PassiveIDArgs:     &PassiveIDArgs{ret: "name2"},
+			want:              &Result{Winners: []string{"name2"}, Losers: []string{"name1"}, Reason: ReasonConcede},
+		},
+		{
+			name:              "draw agreed",
+			args:              args{iter: 1, actions: []Action{AgreeDraw("name1")}},
+			ActiveSetManaArgs: &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:    &ActiveDrawArgs{ret: nil},
+			ActiveIDArgs:      &ActiveIDArgs{"name1"},
+			PassiveIDArgs:     &PassiveIDArgs{ret: "name2"},
+			want:              &Result{Winners: []string{"name1", "name2"}, Losers: []string{}, Draw: true, Reason: ReasonDrawAgreed},
+		},
+		{
+			name:              "another player's action is refused",
+			args:              args{iter: 1, actions: []Action{EndTurn("name2"), EndTurn("name1")}},
+			ActiveSetManaArgs: &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:    &ActiveDrawArgs{ret: nil},
+			ActiveIDArgs:      &ActiveIDArgs{"name1"},
+			PassiveIDArgs:     &PassiveIDArgs{ret: "name2"},
+			want:              nil,
+		},
+		{
+			name:              "disconnect forfeits",
+			args:              args{iter: 1, actions: []Action{Disconnect("name1")}},
+			ActiveSetManaArgs: &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:    &ActiveDrawArgs{ret: nil},
+			ActiveIDArgs:      &ActiveIDArgs{"name1"},
+			PassiveIDArgs:     &PassiveIDArgs{ret: "name2"},
+			want:              &Result{Winners: []string{"name2"}, Losers: []string{"name1"}, Reason: ReasonDisconnect},
+		},
+		{
+			name:              "no hero power keeps the turn going",
+			args:              args{iter: 1, actions: []Action{HeroPower("name1", ""), EndTurn("name1")}},
+			ActiveSetManaArgs: &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:    &ActiveDrawArgs{ret: nil},
+			ActiveIDArgs:      &ActiveIDArgs{"name1"},
+			PassiveIDArgs:     &PassiveIDArgs{ret: "name2"},
+			want:              nil,
+		},
+		{
+			name:              "unknown target and attacking without minions are refused",
+			args:              args{iter: 1, actions: []Action{PlayCard("name1", 0, "bob"), Attack("name1", 0, ""), EndTurn("name1")}},
+			ActiveSetManaArgs: &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:    &ActiveDrawArgs{ret: nil},
+			ActiveIDArgs:      &ActiveIDArgs{"name1"},
+			PassiveIDArgs:     &PassiveIDArgs{ret: "name2"},
+			want:              nil,
+		},
+		{
+			name:                   "play at a named opponent",
+			args:                   args{iter: 1, actions: []Action{PlayCard("name1", 0, "name2")}},
+			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:         &ActiveDrawArgs{ret: nil},
+			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{0}, damage: []int{5}, err: []error{nil}},
+			ActiveIDArgs:           &ActiveIDArgs{"name1"},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{false}},
+			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: 5},
+			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
+			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{true}},
+			want:                   &Result{Winners: []string{"name1"}, Losers: []string{"name2"}, Reason: ReasonKilled},
+		},
+		{
+			name:                  "burn damage kills the active player",
+			args:                  args{iter: 1},
+			ActiveSetManaArgs:     &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:        &ActiveDrawArgs{ret: errs.ErrDeckEmpty},
+			ActiveApplyDamageArgs: &ActiveApplyDamageArgs{damage: 1},
+			ActiveIDArgs:          &ActiveIDArgs{"name1"},
+			ActiveIsDeadArgs:      &ActiveIsDeadArgs{[]bool{true}},
+			PassiveIDArgs:         &PassiveIDArgs{ret: "name2"},
+			PassiveIsDeadArgs:     &PassiveIsDeadArgs{[]bool{false}},
+			want:                  &Result{Winners: []string{"name2"}, Losers: []string{"name1"}, Reason: ReasonKilled},
+		},
+		{
+			name:                   "both heroes dead is a draw",
+			args:                   args{iter: 1, actions: []Action{PlayCard("name1", 0, "")}},
+			ActiveSetManaArgs:      &ActiveSetManaArgs{in: 1},
+			ActiveDrawArgs:         &ActiveDrawArgs{ret: nil},
+			ActivePlayCardArgs:     &ActivePlayCardArgs{index: []int{0}, damage: []int{5}, err: []error{nil}},
+			ActiveIDArgs:           &ActiveIDArgs{"name1"},
+			ActiveIsDeadArgs:       &ActiveIsDeadArgs{[]bool{true}},
+			PassiveGetHealthArgs:   &PassiveGetHealthArgs{health: 5},
+			PassiveIDArgs:          &PassiveIDArgs{ret: "name2"},
+			PassiveApplyDamageArgs: &PassiveApplyDamageArgs{5},
+			PassiveIsDeadArgs:      &PassiveIsDeadArgs{[]bool{true}},
+			want:                   &Result{Winners: []string{"name1", "name2"}, Losers: []string{}, Draw: true, Reason: ReasonSimultaneousDeath},
 		},
 	}
 	for _, tt := range tests {
@@ -308,9 +392,6 @@ func TestTurn(t *testing.T) {
 			if tt.ActiveApplyDamageArgs != nil {
 				active.On("ApplyDamage", tt.ActiveApplyDamageArgs.damage)
 			}
-			if tt.ActivePrintStatsArgs != nil {
-				active.On("PrintStats")
-			}
 			if tt.ActivePlayCardArgs != nil {
 				for ind := range tt.ActivePlayCardArgs.index {
 					active.On("PlayCard", tt.ActivePlayCardArgs.index[ind]).Return(tt.ActivePlayCardArgs.damage[ind], tt.ActivePlayCardArgs.err[ind])
@@ -319,8 +400,10 @@ func TestTurn(t *testing.T) {
 			if tt.ActiveIDArgs != nil {
 				active.On("ID").Return(tt.ActiveIDArgs.ret)
 			}
-			if tt.ActiveGetHealthArgs != nil {
-				active.On("GetHealth").Return(tt.ActiveGetHealthArgs.health)
+			if tt.ActiveIsDeadArgs != nil {
+				for _, ret := range tt.ActiveIsDeadArgs.ret {
+					active.On("IsDead").Return(ret).Once()
+				}
 			}
 			passive := mockPlayer{}
 			if tt.PassiveGetHealthArgs != nil {
@@ -333,14 +416,12 @@ func TestTurn(t *testing.T) {
 				passive.On("ApplyDamage", tt.PassiveApplyDamageArgs.damage)
 			}
 			if tt.PassiveIsDeadArgs != nil {
-				passive.On("IsDead").Return(tt.PassiveIsDeadArgs.ret)
-			}
-			mockUserInputInst := mockUserInput{input: tt.args.userInput}
-			// g := &Game{userInput: mockUserInputInst.get}
-			if got := Turn(tt.args.iter, &active, &passive, mockUserInputInst.get); got != tt.want {
-				t.Errorf("Turn() = %v, want %v", got, tt.want)
-				assert.Equal(t, 1, 1)
+				for _, ret := range tt.PassiveIsDeadArgs.ret {
+					passive.On("IsDead").Return(ret).Once()
+				}
 			}
+			u := &script{actions: tt.args.actions}
+			assert.Equal(t, tt.want, NewRules(NewEventLog()).Play(tt.args.iter, &active, NewTable([]Player{&active, &passive}, nil), u.next))
 			active.AssertExpectations(t)
 			passive.AssertExpectations(t)
 		})
@@ -383,39 +464,101 @@ func Test_min(t *testing.T) {
 	}
 }
 
+func newMockPlayer(id string) *mockPlayer {
+	m := &mockPlayer{}
+	m.On("ID").Return(id)
+	return m
+}
+
 func TestNewGame(t *testing.T) {
-	type args struct {
-		p1        Player
-		p2        Player
-		userInput func() string
-		turn      func(iter int, active, passive Player, getInput func() string) bool
+	p1 := newMockPlayer("p1")
+	p2 := newMockPlayer("p2")
+	want := &Game{
+		table: &Table{
+			players: []Player{p1, p2},
+			teams:   map[string]int{"p1": 0, "p2": 1},
+			out:     map[string]bool{},
+		},
+	}
+	if got := NewGame(p1, p2, nil, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewGame() = %v, want %v", got, want)
+	}
+}
+
+func TestNewTeamGame(t *testing.T) {
+	a1 := newMockPlayer("a1")
+	a2 := newMockPlayer("a2")
+	b1 := newMockPlayer("b1")
+	b2 := newMockPlayer("b2")
+	b3 := newMockPlayer("b3")
+	want := &Game{
+		table: &Table{
+			players: []Player{a1, b1, a2, b2, b3},
+			teams:   map[string]int{"a1": 0, "a2": 0, "b1": 1, "b2": 1, "b3": 1},
+			out:     map[string]bool{},
+		},
+	}
+	if got := NewTeamGame([][]Player{{a1, a2}, {b1, b2, b3}}, nil, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewTeamGame() = %v, want %v", got, want)
+	}
+}
+
+func TestGame_StartSkipsEliminatedPlayers(t *testing.T) {
+	p1 := newMockPlayer("p1")
+	p2 := newMockPlayer("p2")
+	p3 := newMockPlayer("p3")
+	for _, p := range []*mockPlayer{p1, p2, p3} {
+		p.On("Draw").Return(nil)
 	}
+	turner := &mockTurner{}
+	g := NewFreeForAll([]Player{p1, p2, p3}, nil, turner.turn)
+	turner.On("turn", 1, p1, mock.Anything, mock.Anything).Return((*Result)(nil)).Run(func(args mock.Arguments) {
+		args.Get(2).(*Table).Eliminate(p2)
+	})
+	turner.On("turn", 2, p3, mock.Anything, mock.Anything).Return((*Result)(nil))
+	over := &Result{Winners: []string{"p1"}, Losers: []string{"p2", "p3"}, Reason: ReasonKilled}
+	turner.On("turn", 3, p1, mock.Anything, mock.Anything).Return(over)
+
+	assert.Equal(t, over, g.Start())
+	turner.AssertExpectations(t)
+}
+
+func TestGame_StartMana(t *testing.T) {
 	tests := []struct {
-		name string
-		args args
-		want *Game
+		name        string
+		ids         []string
+		manaByRound bool
+		want        map[string][]int
 	}{
-		{
-			name: "Happy",
-			args: args{
-				p1:        &mockPlayer{},
-				p2:        &mockPlayer{},
-				userInput: nil,
-				turn:      nil,
-			},
-			want: &Game{
-				p1:        &mockPlayer{},
-				p2:        &mockPlayer{},
-				userInput: nil,
-				turn:      nil,
-			},
-		},
+		{name: "by turn", ids: []string{"p1", "p2"}, want: map[string][]int{"p1": {1, 3}, "p2": {2, 4}}},
+		{name: "by round", ids: []string{"p1", "p2", "p3", "p4"}, manaByRound: true, want: map[string][]int{"p1": {1, 2}, "p2": {1, 2}, "p3": {1, 2}, "p4": {1, 2}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewGame(tt.args.p1, tt.args.p2, tt.args.userInput, tt.args.turn); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("NewGame() = %v, want %v", got, tt.want)
-			}
+			assert.Equal(t, tt.want, manaPerTurn(tt.ids, tt.manaByRound))
 		})
 	}
 }
+
+// manaPerTurn plays two rounds of ended turns and returns the mana everyone had on theirs
+func manaPerTurn(ids []string, manaByRound bool) map[string][]int {
+	players := []Player{}
+	for _, id := range ids {
+		players = append(players, &cardPlayer{id: id, health: 30})
+	}
+	ruleset := DefaultRuleset()
+	ruleset.ManaByRound = manaByRound
+	rules := NewRulesWith(NewEventLog(), ruleset)
+	mana := map[string][]int{}
+	g := NewFreeForAll(players, nil, func(iter int, active Player, table *Table, getInput func() string) *Result {
+		if table.Round() > 2 {
+			return table.agreeDraw()
+		}
+		result := rules.Play(iter, active, table, func() Action { return EndTurn(active.ID()) })
+		mana[active.ID()] = append(mana[active.ID()], active.(*cardPlayer).mana)
+		return result
+	})
+
+	g.Start()
+	return mana
+}
@@ -0,0 +1,77 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+)
+
+// History plays a GameState through the rules and keeps every state so actions can be undone and redone.
+// Deck order is part of the state, so undoing can't reroll a draw, and an undone action played again
+// comes out the way it did the first time.
+type History struct {
+	rules   *Rules
+	states  []GameState
+	actions []Action
+	events  [][]Event
+	// at is the index in states of the current state, states past it can be redone
+	at int
+	// acrossTurns lets undo go back past the start of the current turn
+	acrossTurns bool
+}
+
+func NewHistory(rules *Rules, start GameState, acrossTurns bool) *History {
+	return &History{
+		rules:       rules,
+		states:      []GameState{start},
+		acrossTurns: acrossTurns,
+	}
+}
+
+func (h *History) State() GameState {
+	return h.states[h.at]
+}
+
+// Apply plays action on the current state, anything that could have been redone is forgotten. Playing
+// the action that was just undone redoes it instead, so undoing can't reroll an effect.
+func (h *History) Apply(action Action) ([]Event, error) {
+	if h.at < len(h.actions) && h.actions[h.at] == action {
+		_, events, err := h.Redo()
+		return events, err
+	}
+	next, events, err := h.rules.Apply(h.State(), action)
+	if err != nil {
+		return nil, err
+	}
+	h.states = append(h.states[:h.at+1], next)
+	h.actions = append(h.actions[:h.at], action)
+	h.events = append(h.events[:h.at], events)
+	h.at++
+	return events, nil
+}
+
+// Undo steps back one action and returns it
+func (h *History) Undo() (Action, error) {
+	if h.at == 0 {
+		return Action{}, fmt.Errorf("%w, there is nothing to undo", errs.ErrIllegalAction)
+	}
+	if !h.acrossTurns && h.states[h.at-1].Turn != h.State().Turn {
+		return Action{}, fmt.Errorf("%w, can't undo past the start of the turn", errs.ErrIllegalAction)
+	}
+	h.at--
+	return h.actions[h.at], nil
+}
+
+// Redo plays the last undone action again with the same outcome, it returns the action and what happened
+func (h *History) Redo() (Action, []Event, error) {
+	if h.at == len(h.actions) {
+		return Action{}, nil, fmt.Errorf("%w, there is nothing to redo", errs.ErrIllegalAction)
+	}
+	h.at++
+	return h.actions[h.at-1], h.events[h.at-1], nil
+}
+
+// Actions are the actions that led to the current state, in order
+func (h *History) Actions() []Action {
+	return append([]Action{}, h.actions[:h.at]...)
+}
@@ -0,0 +1,103 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistory_UndoRedo(t *testing.T) {
+	h := NewHistory(NewRules(NewEventLog()), midGame(), false)
+	_, err := h.Apply(PlayCard("p1", 2, ""))
+	assert.NoError(t, err)
+	_, err = h.Apply(PlayCard("p1", 0, ""))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{5}, h.State().Players[0].Hand)
+
+	a, err := h.Undo()
+	assert.NoError(t, err)
+	assert.Equal(t, PlayCard("p1", 0, ""), a)
+	assert.Equal(t, []int{2, 5}, h.State().Players[0].Hand)
+	assert.Equal(t, []Action{PlayCard("p1", 2, "")}, h.Actions())
+
+	a, events, err := h.Redo()
+	assert.NoError(t, err)
+	assert.Equal(t, PlayCard("p1", 0, ""), a)
+	assert.Equal(t, EventCardPlayed, events[0].Kind)
+	assert.Equal(t, []int{5}, h.State().Players[0].Hand)
+
+	_, _, err = h.Redo()
+	assert.True(t, errors.Is(err, errs.ErrIllegalAction))
+}
+
+func TestHistory_ApplyForgetsRedo(t *testing.T) {
+	h := NewHistory(NewRules(NewEventLog()), midGame(), false)
+	h.Apply(PlayCard("p1", 0, ""))
+	h.Undo()
+	h.Apply(HeroPower("p1", ""))
+	_, _, err := h.Redo()
+	assert.True(t, errors.Is(err, errs.ErrIllegalAction))
+	assert.Equal(t, []Action{HeroPower("p1", "")}, h.Actions())
+}
+
+func TestHistory_ApplyAfterUndoKeepsTheRoll(t *testing.T) {
+	rolls := 0
+	ruleset := DefaultRuleset()
+	ruleset.Effects = map[int]Effect{20: {Name: "Roll", Targeted: true, Cast: func(caster, target Player) (int, string) {
+		rolls++
+		return rolls, fmt.Sprintf("rolls %d", rolls)
+	}}}
+	ruleset.Cost = func(card int) int { return 1 }
+	start := midGame()
+	start.Players[0].Hand = []int{20}
+	start.Players[1].Health = 10
+	h := NewHistory(NewRulesWith(NewEventLog(), ruleset), start, false)
+	_, err := h.Apply(PlayCard("p1", 0, "p2"))
+	assert.NoError(t, err)
+	rolled := h.State().Players[1]
+
+	_, err = h.Undo()
+	assert.NoError(t, err)
+	events, err := h.Apply(PlayCard("p1", 0, "p2"))
+	assert.NoError(t, err)
+	assert.Equal(t, rolled, h.State().Players[1], "playing the card again doesn't roll again")
+	assert.Contains(t, events, Event{Turn: 3, Player: "p1", Kind: EventEffect, Detail: "Roll, rolls 1"})
+	assert.Equal(t, 1, rolls)
+}
+
+func TestHistory_UndoAcrossTurns(t *testing.T) {
+	tests := []struct {
+		name        string
+		acrossTurns bool
+		wantErr     bool
+	}{
+		{name: "stops at the start of the turn", acrossTurns: false, wantErr: true},
+		{name: "goes back past it", acrossTurns: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := midGame()
+			start.Players[1].Deck = []int{7, 8}
+			h := NewHistory(NewRules(NewEventLog()), start, tt.acrossTurns)
+			h.Apply(EndTurn("p1"))
+			drawn := h.State().Players[1].Hand
+
+			_, err := h.Undo()
+			assert.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, "p1", h.State().ActivePlayer().ID)
+			h.Apply(EndTurn("p1"))
+			assert.Equal(t, drawn, h.State().Players[1].Hand, "ending the turn again draws the same card")
+		})
+	}
+}
+
+func TestHistory_NothingToUndo(t *testing.T) {
+	_, err := NewHistory(NewRules(NewEventLog()), midGame(), true).Undo()
+	assert.True(t, errors.Is(err, errs.ErrIllegalAction))
+}
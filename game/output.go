@@ -0,0 +1,18 @@
+package game
+
+import (
+	"io"
+	"os"
+)
+
+var out io.Writer = os.Stdout
+
+// SetOutput sends everything the game prints to w
+func SetOutput(w io.Writer) {
+	out = w
+}
+
+// Output is where the game is currently printing
+func Output() io.Writer {
+	return out
+}
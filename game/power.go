@@ -0,0 +1,79 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+)
+
+// PowerState is a hero power as plain data, what it does to the target and to its owner. Heroes and
+// game states both use their powers through Use.
+type PowerState struct {
+	Name     string
+	Cost     int
+	Targeted bool
+	// Damage is dealt to the target
+	Damage int
+	Armor  int
+	// Draw is cards the owner draws
+	Draw int
+	// SelfDamage is taken by the owner
+	SelfDamage int
+}
+
+// PowerUser is a player who pays for a hero power and can be changed by it
+type PowerUser interface {
+	Player
+	GetMana() int
+	GainArmor(int)
+}
+
+// Afford checks user has the mana to pay for the power
+func (p PowerState) Afford(user PowerUser) error {
+	if user.GetMana() < p.Cost {
+		return fmt.Errorf("%w, %s costs %d and you have %d", errs.ErrNotEnoughMana, p.Name, p.Cost, user.GetMana())
+	}
+	return nil
+}
+
+// Use pays for the power out of user's mana and does what it does, target is nil for powers that
+// aren't targeted. It describes what happened for the log.
+func (p PowerState) Use(user PowerUser, target Player) (string, error) {
+	if err := p.Afford(user); err != nil {
+		return "", err
+	}
+	user.SetMana(user.GetMana() - p.Cost)
+	did := []string{}
+	if p.Damage > 0 && target != nil {
+		target.ApplyDamage(p.Damage)
+		did = append(did, fmt.Sprintf("%d damage to %s", p.Damage, target.ID()))
+	}
+	if p.Armor > 0 {
+		user.GainArmor(p.Armor)
+		did = append(did, fmt.Sprintf("gains %d armor", p.Armor))
+	}
+	for i := 0; i < p.Draw; i++ {
+		switch err := user.Draw(); {
+		case errors.Is(err, errs.ErrDeckEmpty):
+			did = append(did, "draws nothing")
+		case errors.Is(err, errs.ErrHandFull):
+			did = append(did, "burns a card")
+		default:
+			did = append(did, "draws a card")
+		}
+	}
+	if p.SelfDamage > 0 {
+		user.ApplyDamage(p.SelfDamage)
+		did = append(did, fmt.Sprintf("takes %d damage", p.SelfDamage))
+	}
+	if len(did) == 0 {
+		return "uses " + p.Name, nil
+	}
+	last := len(did) - 1
+	if last == 0 {
+		return fmt.Sprintf("uses %s, %s", p.Name, did[0]), nil
+	}
+	return fmt.Sprintf("uses %s, %s and %s", p.Name, strings.Join(did[:last], ", "), did[last]), nil
+}
@@ -0,0 +1,308 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+)
+
+// Ruleset is what can change between game modes
+type Ruleset struct {
+	MaxMana int
+	// BurnDamage is taken when drawing from an empty deck
+	BurnDamage int
+	// ExtraMana is added to a player's mana every turn, by player ID
+	ExtraMana map[string]int
+	// Secrets are the cards that are played face down instead of dealing damage, by card value
+	Secrets map[int]Secret
+	// Effects are the cards that do something other than damage, by card value
+	Effects map[int]Effect
+	// ManaByRound gives players mana by the round instead of their turn count, so with more than two
+	// players nobody waits a lap for the next mana
+	ManaByRound bool
+	// Cost is what a card costs to play in a game state, nil when every card costs its value. Live
+	// players pay for their cards themselves.
+	Cost func(card int) int
+}
+
+func DefaultRuleset() Ruleset {
+	return Ruleset{
+		MaxMana:    10,
+		BurnDamage: 1,
+	}
+}
+
+// Rules plays turns and records what happens in them, live games and game states are played by the
+// same rules
+type Rules struct {
+	log     *EventLog
+	ruleset Ruleset
+	// secrets are the card values of everyone's face down secrets, by player ID
+	secrets map[string][]int
+	// random picks where a card shuffled into a game state's deck goes, like rand.Intn
+	random func(int) int
+	// quiet rules print nothing, for game states
+	quiet bool
+}
+
+func NewRules(log *EventLog) *Rules {
+	return NewRulesWith(log, DefaultRuleset())
+}
+
+func NewRulesWith(log *EventLog, ruleset Ruleset) *Rules {
+	return &Rules{
+		log:     log,
+		ruleset: ruleset,
+		secrets: map[string][]int{},
+		random:  rand.Intn,
+	}
+}
+
+// Play runs active's turn, next is each action they take until one ends it. An action that isn't
+// allowed is refused, the reason is printed and the turn goes on.
+func (r *Rules) Play(iter int, active Player, table *Table, next func() Action) *Result {
+	if result, over := r.begin(iter, active, table); over {
+		return result
+	}
+	powered := false
+	for {
+		result, over, err := r.act(iter, active, table, next(), &powered)
+		if err != nil {
+			fmt.Fprintln(r.output(), err)
+			continue
+		}
+		if over {
+			return result
+		}
+	}
+}
+
+// Events is everything the rules have recorded
+func (r *Rules) Events() []Event {
+	return r.log.Events()
+}
+
+// begin gives active their mana and card for the turn, it reports whether that already ended the turn
+// along with the result if it ended the game
+func (r *Rules) begin(iter int, active Player, table *Table) (*Result, bool) {
+	fmt.Fprintf(r.output(), "%s's turn!\n", active.ID())
+	turn := iter
+	if r.ruleset.ManaByRound {
+		turn = table.Round()
+	}
+	mana := min(turn, r.ruleset.MaxMana) + r.ruleset.ExtraMana[active.ID()]
+	active.SetMana(mana)
+	r.record(iter, active, EventTurnStart, fmt.Sprintf("%d mana", mana))
+	sprung, err := r.draw(iter, active)
+	if errors.Is(err, errs.ErrHandFull) {
+		fmt.Fprintln(r.output(), err)
+	}
+	burned := errors.Is(err, errs.ErrDeckEmpty)
+	if burned {
+		fmt.Fprintln(r.output(), "You tried to draw with no cards in your deck! Applying burn damage")
+		active.ApplyDamage(r.ruleset.BurnDamage) // no deck
+		r.record(iter, active, EventBurn, fmt.Sprintf("%d damage", r.ruleset.BurnDamage))
+	}
+	if burned || sprung {
+		result := table.Settle(ReasonKilled)
+		return result, result != nil || table.IsOut(active)
+	}
+	return nil, false
+}
+
+// act plays one of active's actions, it reports whether their turn is over along with the result if
+// the game is. powered is whether they've used their hero power this turn.
+func (r *Rules) act(iter int, active Player, table *Table, a Action, powered *bool) (*Result, bool, error) {
+	if a.Player != active.ID() {
+		return nil, false, fmt.Errorf("%w, it's %s's turn", errs.ErrNotYourTurn, active.ID())
+	}
+	var result *Result
+	var err error
+	switch a.Kind {
+	case ActionEndTurn:
+		return nil, true, nil
+	case ActionConcede:
+		fmt.Fprintln(r.output(), active.ID(), "concedes")
+		r.record(iter, active, EventConcede, "")
+		table.Eliminate(active)
+		return table.outcome([]Player{active}, ReasonConcede), true, nil
+	case ActionDisconnect:
+		fmt.Fprintln(r.output(), active.ID(), "disconnected and forfeits")
+		table.Eliminate(active)
+		return table.outcome([]Player{active}, ReasonDisconnect), true, nil
+	case ActionAgreeDraw:
+		fmt.Fprintln(r.output(), "Draw agreed")
+		return table.agreeDraw(), true, nil
+	case ActionAttack:
+		return nil, false, fmt.Errorf("%w, there are no minions on the board to attack with", errs.ErrIllegalAction)
+	case ActionHeroPower:
+		if result, err = r.usePower(iter, active, table, a.Target, *powered); err == nil {
+			*powered = true
+		}
+	case ActionPlayCard:
+		result, err = r.play(iter, active, table, a.Card, a.Target)
+	default:
+		err = fmt.Errorf("%w, unknown action %q", errs.ErrIllegalAction, a.Kind)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return result, result != nil || table.IsOut(active), nil
+}
+
+// play plays the card at index in active's hand, aimed at the opponent named by aim when it needs a
+// target. It returns the result if that ended the game.
+func (r *Rules) play(iter int, active Player, table *Table, index int, aim string) (*Result, error) {
+	var target Player
+	if r.needsTarget(active, index) {
+		var err error
+		if target, err = chooseTarget(aim, table.Opponents(active)); err != nil {
+			return nil, err
+		}
+	}
+	card, err := active.PlayCard(index)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := r.ruleset.Secrets[card]; ok {
+		r.arm(iter, active, card)
+		return nil, nil
+	}
+	damage := card
+	e, isEffect := r.ruleset.Effects[card]
+	if isEffect && target == nil {
+		// an effect aimed at nobody is played at every opponent, any of them can counter it
+		countered := false
+		for _, o := range table.Opponents(active) {
+			if r.Secrets(o) > 0 && !countered {
+				_, countered = r.spring(iter, TriggerCardPlayed, o, active, 0)
+			}
+		}
+		if !countered {
+			r.cast(iter, active, nil, e)
+		}
+		return table.Settle(ReasonKilled), nil
+	}
+	if isEffect {
+		damage = 0
+	}
+	// secrets spring before an effect is cast so a countered effect never happens
+	damage, countered := r.spring(iter, TriggerCardPlayed, target, active, damage)
+	if isEffect && !countered {
+		damage = r.cast(iter, active, target, e)
+	}
+	if damage > 0 && damage >= health(target) {
+		damage, _ = r.spring(iter, TriggerLethal, target, active, damage)
+	}
+	target.ApplyDamage(damage)
+	r.record(iter, active, EventCardPlayed, fmt.Sprintf("%d damage to %s", damage, target.ID()))
+	return table.Settle(ReasonKilled), nil
+}
+
+// usePower uses active's hero power at the opponent named by aim, it returns the result if that ended
+// the game
+func (r *Rules) usePower(iter int, active Player, table *Table, aim string, powered bool) (*Result, error) {
+	hp, ok := heroPower(active)
+	if !ok {
+		return nil, fmt.Errorf("%w, %s has no hero power", errs.ErrIllegalAction, active.ID())
+	}
+	if powered {
+		return nil, fmt.Errorf("%w, the hero power was already used this turn", errs.ErrIllegalAction)
+	}
+	var target Player
+	if hp.PowerTargeted() {
+		var err error
+		if target, err = chooseTarget(aim, table.Opponents(active)); err != nil {
+			return nil, err
+		}
+	}
+	// a power that can't be paid for never gets as far as setting off a secret
+	if err := hp.CanUsePower(); err != nil {
+		return nil, err
+	}
+	if target != nil && r.Secrets(target) > 0 {
+		r.spring(iter, TriggerAttacked, target, active, 0)
+		if result := table.Settle(ReasonKilled); result != nil || table.IsOut(active) {
+			return result, nil
+		}
+	}
+	detail, err := hp.UsePower(target)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintln(r.output(), active.ID(), detail)
+	r.record(iter, active, EventHeroPower, detail)
+	return table.Settle(ReasonKilled), nil
+}
+
+func (r *Rules) record(iter int, p Player, kind EventKind, detail string) {
+	r.log.Record(Event{Turn: iter, Player: p.ID(), Kind: kind, Detail: detail})
+}
+
+func (r *Rules) output() io.Writer {
+	if r.quiet {
+		return ioutil.Discard
+	}
+	return out
+}
+
+// cost is what card costs to play from a game state
+func (r *Rules) cost(card int) int {
+	if r.ruleset.Cost == nil {
+		return card
+	}
+	return r.ruleset.Cost(card)
+}
+
+// targeted is whether card is aimed at an opponent, secrets and untargeted effects aren't
+func (r *Rules) targeted(card int) bool {
+	if _, ok := r.ruleset.Secrets[card]; ok {
+		return false
+	}
+	if e, ok := r.ruleset.Effects[card]; ok {
+		return e.Targeted
+	}
+	return true
+}
+
+// needsTarget peeks at the card about to be played, a card that isn't there needs one so playing it
+// fails on the index
+func (r *Rules) needsTarget(active Player, index int) bool {
+	for p := active; ; {
+		if h, ok := p.(interface{ ShowHand() []int }); ok {
+			hand := h.ShowHand()
+			if index < 0 || index >= len(hand) {
+				return true
+			}
+			return r.targeted(hand[index])
+		}
+		w, ok := p.(Wrapper)
+		if !ok {
+			return true
+		}
+		p = w.Unwrap()
+	}
+}
+
+// chooseTarget picks the opponent named by target, it can be left empty with a single opponent
+func chooseTarget(target string, opponents []Player) (Player, error) {
+	names := make([]string, len(opponents))
+	for i, o := range opponents {
+		if o.ID() == target {
+			return o, nil
+		}
+		names[i] = o.ID()
+	}
+	if target == "" && len(opponents) == 1 {
+		return opponents[0], nil
+	}
+	if target == "" {
+		return nil, fmt.Errorf("%w, choose who to aim at, opponents are: %s", errs.ErrIllegalAction, strings.Join(names, ", "))
+	}
+	return nil, fmt.Errorf("%w, unknown target %q, opponents are: %s", errs.ErrIllegalAction, target, strings.Join(names, ", "))
+}
@@ -0,0 +1,214 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+// script plays its actions in order
+type script struct {
+	called  int
+	actions []Action
+}
+
+func (s *script) next() Action {
+	ret := s.actions[s.called]
+	s.called++
+	return ret
+}
+
+func TestRules_Play(t *testing.T) {
+	active := &mockPlayer{}
+	active.On("ID").Return("name1")
+	active.On("SetMana", 3)
+	active.On("Draw").Return(nil)
+	active.On("PlayCard", 0).Return(2, nil)
+	active.On("IsDead").Return(false)
+	passive := &mockPlayer{}
+	passive.On("ID").Return("name2")
+	passive.On("GetHealth").Return(10)
+	passive.On("ApplyDamage", 2)
+	passive.On("IsDead").Return(false)
+	log := NewEventLog()
+	u := &script{actions: []Action{PlayCard("name1", 0, ""), EndTurn("name1")}}
+
+	result := NewRules(log).Play(3, active, NewTable([]Player{active, passive}, nil), u.next)
+
+	assert.Nil(t, result)
+	assert.Equal(t, []Event{
+		{Turn: 3, Player: "name1", Kind: EventTurnStart, Detail: "3 mana"},
+		{Turn: 3, Player: "name1", Kind: EventCardPlayed, Detail: "2 damage to name2"},
+	}, log.Events())
+	active.AssertExpectations(t)
+	passive.AssertExpectations(t)
+}
+
+func Test_chooseTarget(t *testing.T) {
+	p2 := newMockPlayer("p2")
+	p3 := newMockPlayer("p3")
+	tests := []struct {
+		name      string
+		target    string
+		opponents []Player
+		want      Player
+		wantErr   string
+	}{
+		{name: "only opponent", target: "", opponents: []Player{p2}, want: p2},
+		{name: "by name", target: "p3", opponents: []Player{p2, p3}, want: p3},
+		{name: "must choose", target: "", opponents: []Player{p2, p3}, wantErr: "illegal action, choose who to aim at, opponents are: p2, p3"},
+		{name: "unknown", target: "p9", opponents: []Player{p2, p3}, wantErr: `illegal action, unknown target "p9", opponents are: p2, p3`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := chooseTarget(tt.target, tt.opponents)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRules_PlayFreeForAll(t *testing.T) {
+	active := newMockPlayer("p1")
+	active.On("SetMana", 2)
+	active.On("Draw").Return(nil)
+	active.On("PlayCard", 0).Return(2, nil)
+	active.On("IsDead").Return(false)
+	p2 := newMockPlayer("p2")
+	p2.On("GetHealth").Return(10)
+	p2.On("IsDead").Return(false)
+	p3 := newMockPlayer("p3")
+	p3.On("GetHealth").Return(2)
+	p3.On("ApplyDamage", 2)
+	p3.On("IsDead").Return(true)
+	table := NewTable([]Player{active, p2, p3}, nil)
+	u := &script{actions: []Action{PlayCard("p1", 0, ""), PlayCard("p1", 0, "p3"), EndTurn("p1")}}
+
+	result := NewRules(NewEventLog()).Play(2, active, table, u.next)
+
+	assert.Nil(t, result, "p2 is still in the game")
+	assert.True(t, table.IsOut(p3))
+	active.AssertNotCalled(t, "PlayCard", 1)
+	p3.AssertExpectations(t)
+}
+
+func TestNewRulesWith(t *testing.T) {
+	active := &mockPlayer{}
+	active.On("ID").Return("boss")
+	active.On("SetMana", 8)
+	active.On("Draw").Return(errs.ErrDeckEmpty)
+	active.On("ApplyDamage", 3)
+	active.On("IsDead").Return(false)
+	passive := newMockPlayer("p2")
+	passive.On("IsDead").Return(false)
+	log := NewEventLog()
+	u := &script{actions: []Action{EndTurn("boss")}}
+	ruleset := Ruleset{MaxMana: 6, BurnDamage: 3, ExtraMana: map[string]int{"boss": 2}}
+
+	result := NewRulesWith(log, ruleset).Play(9, active, NewTable([]Player{active, passive}, nil), u.next)
+
+	assert.Nil(t, result)
+	assert.Equal(t, []Event{
+		{Turn: 9, Player: "boss", Kind: EventTurnStart, Detail: "8 mana"},
+		{Turn: 9, Player: "boss", Kind: EventBurn, Detail: "3 damage"},
+	}, log.Events())
+	active.AssertExpectations(t)
+}
+
+type poweredPlayer struct {
+	*mockPlayer
+	targeted bool
+	err      error
+	aimedAt  []Player
+}
+
+func (p *poweredPlayer) PowerTargeted() bool {
+	return p.targeted
+}
+
+func (p *poweredPlayer) CanUsePower() error {
+	return p.err
+}
+
+func (p *poweredPlayer) UsePower(target Player) (string, error) {
+	if p.err != nil {
+		return "", p.err
+	}
+	p.aimedAt = append(p.aimedAt, target)
+	if target != nil {
+		target.ApplyDamage(1)
+		return "deals 1 damage to " + target.ID(), nil
+	}
+	return "gains 2 armor", nil
+}
+
+// wrapped hides the hero power behind another player, like the UI does
+type wrapped struct {
+	Player
+}
+
+func (w *wrapped) Unwrap() Player {
+	return w.Player
+}
+
+func TestRules_PlayHeroPower(t *testing.T) {
+	tests := []struct {
+		name       string
+		targeted   bool
+		err        error
+		actions    []Action
+		wrap       bool
+		wantAimed  int
+		wantEvents int
+	}{
+		{name: "untargeted once a turn", actions: []Action{HeroPower("p1", ""), HeroPower("p1", ""), EndTurn("p1")}, wantAimed: 1, wantEvents: 2},
+		{name: "targeted", targeted: true, actions: []Action{HeroPower("p1", "p2"), EndTurn("p1")}, wantAimed: 1, wantEvents: 2},
+		{name: "bad target", targeted: true, actions: []Action{HeroPower("p1", "p9"), EndTurn("p1")}, wantAimed: 0, wantEvents: 1},
+		{name: "not enough mana", err: errors.New("not enough mana"), actions: []Action{HeroPower("p1", ""), EndTurn("p1")}, wantAimed: 0, wantEvents: 1},
+		{name: "through a wrapper", wrap: true, actions: []Action{HeroPower("p1", ""), EndTurn("p1")}, wantAimed: 1, wantEvents: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMockPlayer("p1")
+			m.On("SetMana", 1)
+			m.On("Draw").Return(nil)
+			m.On("IsDead").Return(false)
+			active := &poweredPlayer{mockPlayer: m, targeted: tt.targeted, err: tt.err}
+			passive := newMockPlayer("p2")
+			passive.On("GetHealth").Return(10)
+			passive.On("IsDead").Return(false)
+			passive.On("ApplyDamage", 1)
+			var seated Player = active
+			if tt.wrap {
+				seated = &wrapped{active}
+			}
+			log := NewEventLog()
+			u := &script{actions: tt.actions}
+
+			result := NewRules(log).Play(1, seated, NewTable([]Player{seated, passive}, nil), u.next)
+
+			assert.Nil(t, result)
+			assert.Len(t, active.aimedAt, tt.wantAimed)
+			assert.Len(t, log.Events(), tt.wantEvents)
+		})
+	}
+}
+
+func TestRules_PlayNoHeroPower(t *testing.T) {
+	active := newMockPlayer("p1")
+	active.On("SetMana", 1)
+	active.On("Draw").Return(nil)
+	passive := newMockPlayer("p2")
+	u := &script{actions: []Action{HeroPower("p1", ""), EndTurn("p1")}}
+
+	result := NewRules(NewEventLog()).Play(1, active, NewTable([]Player{active, passive}, nil), u.next)
+
+	assert.Nil(t, result)
+	assert.Equal(t, 2, u.called)
+}
@@ -0,0 +1,66 @@
+package game
+
+import (
+	"fmt"
+)
+
+// Trigger is what a secret waits for on its owner's opponents' turns
+type Trigger string
+
+const (
+	// TriggerCardPlayed is an opponent playing a card at the owner, or one that isn't aimed at anybody
+	TriggerCardPlayed Trigger = "card played"
+	// TriggerAttacked is an opponent aiming their hero power at the owner
+	TriggerAttacked Trigger = "attacked"
+	// TriggerLethal is the owner about to take lethal damage from a card
+	TriggerLethal Trigger = "lethal"
+)
+
+// Secret is a card played face down that springs when its trigger happens
+type Secret struct {
+	Name    string
+	Trigger Trigger
+	// Counters stops the card that set it off, effects included
+	Counters bool
+	// Spring reacts to the trigger, it returns the damage the owner takes from the card or power that
+	// set it off and what happened
+	Spring func(owner, opponent Player, damage int) (int, string)
+}
+
+// arm puts a secret card face down in the owner's hidden zone
+func (r *Rules) arm(iter int, owner Player, card int) {
+	r.secrets[owner.ID()] = append(r.secrets[owner.ID()], card)
+	fmt.Fprintln(r.output(), owner.ID(), "plays a secret")
+	r.record(iter, owner, EventSecretPlayed, "")
+}
+
+// spring sets off the owner's first secret waiting for trigger, it returns the damage the owner is
+// left to take and whether the secret countered what set it off
+func (r *Rules) spring(iter int, trigger Trigger, owner, opponent Player, damage int) (int, bool) {
+	armed := r.secrets[owner.ID()]
+	for i, card := range armed {
+		s := r.ruleset.Secrets[card]
+		if s.Trigger != trigger {
+			continue
+		}
+		r.secrets[owner.ID()] = append(armed[:i:i], armed[i+1:]...)
+		damage, text := s.Spring(owner, opponent, damage)
+		fmt.Fprintf(r.output(), "%s's secret %s: %s\n", owner.ID(), s.Name, text)
+		r.record(iter, owner, EventSecretRevealed, fmt.Sprintf("%s, %s", s.Name, text))
+		return damage, s.Counters
+	}
+	return damage, false
+}
+
+// Secrets counts the face down secrets a player has
+func (r *Rules) Secrets(p Player) int {
+	return len(r.secrets[p.ID()])
+}
+
+// health is what damage has to get through to kill p, armor included
+func health(p Player) int {
+	if a, ok := p.(interface{ GetArmor() int }); ok {
+		return p.GetHealth() + a.GetArmor()
+	}
+	return p.GetHealth()
+}
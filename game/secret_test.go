@@ -0,0 +1,149 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+// cardPlayer plays from a real hand without paying for it
+type cardPlayer struct {
+	id     string
+	health int
+	armor  int
+	mana   int
+	hand   []int
+}
+
+func (p *cardPlayer) GetHealth() int  { return p.health }
+func (p *cardPlayer) GetArmor() int   { return p.armor }
+func (p *cardPlayer) SetMana(m int)   { p.mana = m }
+func (p *cardPlayer) IsDead() bool    { return p.health <= 0 }
+func (p *cardPlayer) Draw() error     { return nil }
+func (p *cardPlayer) ID() string      { return p.id }
+func (p *cardPlayer) PrintStats()     {}
+func (p *cardPlayer) ShowHand() []int { return p.hand }
+func (p *cardPlayer) ApplyDamage(d int) {
+	absorbed := min(d, p.armor)
+	p.armor -= absorbed
+	p.health -= d - absorbed
+}
+
+func (p *cardPlayer) PlayCard(i int) (int, error) {
+	if i >= len(p.hand) {
+		return 0, errors.New("Illegal index")
+	}
+	v := p.hand[i]
+	p.hand = append(p.hand[:i], p.hand[i+1:]...)
+	return v, nil
+}
+
+var testSecrets = map[int]Secret{
+	11: {Name: "Counter", Trigger: TriggerCardPlayed, Counters: true, Spring: func(owner, opponent Player, damage int) (int, string) {
+		return 0, "countered"
+	}},
+	12: {Name: "Trap", Trigger: TriggerAttacked, Spring: func(owner, opponent Player, damage int) (int, string) {
+		opponent.ApplyDamage(2)
+		return damage, "2 damage"
+	}},
+	13: {Name: "Block", Trigger: TriggerLethal, Spring: func(owner, opponent Player, damage int) (int, string) {
+		return 0, "blocked"
+	}},
+}
+
+func secretRules() *Rules {
+	ruleset := DefaultRuleset()
+	ruleset.Secrets = testSecrets
+	return NewRulesWith(NewEventLog(), ruleset)
+}
+
+func TestRules_PlayPlaysSecret(t *testing.T) {
+	p1 := &cardPlayer{id: "p1", health: 10}
+	p2 := &cardPlayer{id: "p2", health: 10, hand: []int{11}}
+	p3 := &cardPlayer{id: "p3", health: 10}
+	r := secretRules()
+	u := &script{actions: []Action{PlayCard("p2", 0, ""), EndTurn("p2")}}
+
+	result := r.Play(1, p2, NewTable([]Player{p1, p2, p3}, nil), u.next)
+
+	assert.Nil(t, result)
+	assert.Equal(t, 1, r.Secrets(p2), "a secret needs no target even with two opponents")
+	assert.Equal(t, 10, p1.health)
+	assert.Equal(t, 10, p3.health)
+	assert.Equal(t, Event{Turn: 1, Player: "p2", Kind: EventSecretPlayed}, r.log.Events()[1])
+}
+
+func TestRules_PlaySpringsSecret(t *testing.T) {
+	tests := []struct {
+		name         string
+		secret       int
+		targetHealth int
+		targetArmor  int
+		card         int
+		wantHealth   int
+		wantArmed    int
+		wantRevealed string
+	}{
+		{name: "counter a card", secret: 11, targetHealth: 10, card: 5, wantHealth: 10, wantRevealed: "Counter, countered"},
+		{name: "block lethal", secret: 13, targetHealth: 4, card: 5, wantHealth: 4, wantRevealed: "Block, blocked"},
+		{name: "not lethal", secret: 13, targetHealth: 10, card: 5, wantHealth: 5, wantArmed: 1},
+		{name: "armor stops it being lethal", secret: 13, targetHealth: 4, targetArmor: 2, card: 5, wantHealth: 1, wantArmed: 1},
+		{name: "wrong trigger", secret: 12, targetHealth: 10, card: 5, wantHealth: 5, wantArmed: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1 := &cardPlayer{id: "p1", health: 10, hand: []int{tt.card}}
+			p2 := &cardPlayer{id: "p2", health: tt.targetHealth, armor: tt.targetArmor}
+			r := secretRules()
+			r.secrets["p2"] = []int{tt.secret}
+			u := &script{actions: []Action{PlayCard("p1", 0, ""), EndTurn("p1")}}
+
+			r.Play(3, p1, NewTable([]Player{p1, p2}, nil), u.next)
+
+			assert.Equal(t, tt.wantHealth, p2.health)
+			assert.Equal(t, tt.wantArmed, r.Secrets(p2))
+			if tt.wantRevealed != "" {
+				assert.Contains(t, r.log.Events(), Event{Turn: 3, Player: "p2", Kind: EventSecretRevealed, Detail: tt.wantRevealed})
+			}
+		})
+	}
+}
+
+func TestRules_PlaySpringsTrapOnHeroPower(t *testing.T) {
+	m := newMockPlayer("p1")
+	m.On("SetMana", 1)
+	m.On("Draw").Return(nil)
+	m.On("ApplyDamage", 2)
+	m.On("IsDead").Return(false)
+	attacker := &poweredPlayer{mockPlayer: m, targeted: true}
+	owner := &cardPlayer{id: "p2", health: 10}
+	r := secretRules()
+	r.secrets["p2"] = []int{12, 11}
+	u := &script{actions: []Action{HeroPower("p1", ""), EndTurn("p1")}}
+
+	result := r.Play(1, attacker, NewTable([]Player{attacker, owner}, nil), u.next)
+
+	assert.Nil(t, result)
+	m.AssertCalled(t, "ApplyDamage", 2)
+	assert.Equal(t, 9, owner.health, "the power still goes off")
+	assert.Equal(t, 1, r.Secrets(owner), "only the trap springs")
+}
+
+func TestRules_PlayUnaffordablePowerLeavesSecret(t *testing.T) {
+	m := newMockPlayer("p1")
+	m.On("SetMana", 1)
+	m.On("Draw").Return(nil)
+	attacker := &poweredPlayer{mockPlayer: m, targeted: true, err: errs.ErrNotEnoughMana}
+	owner := &cardPlayer{id: "p2", health: 10}
+	r := secretRules()
+	r.secrets["p2"] = []int{12}
+	u := &script{actions: []Action{HeroPower("p1", ""), EndTurn("p1")}}
+
+	r.Play(1, attacker, NewTable([]Player{attacker, owner}, nil), u.next)
+
+	m.AssertNotCalled(t, "ApplyDamage", 2)
+	assert.Equal(t, 1, r.Secrets(owner), "the trap is still armed")
+	assert.Empty(t, attacker.aimedAt)
+}
@@ -0,0 +1,456 @@
+package game
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+)
+
+// PlayerState is one player's side of a GameState
+type PlayerState struct {
+	ID     string
+	Team   int
+	Health int
+	Armor  int
+	Mana   int
+	Hand   []int
+	// Deck is in draw order, the top first
+	Deck []int
+	// Secrets are the card values of their face down secrets, in the order they were played
+	Secrets []int
+	// Power is nil for players without a hero power
+	Power *PowerState
+	Out   bool
+}
+
+// GameState is a whole game as values, Rules.Apply plays actions on it by the same rules as live
+// games. The deck order is already decided, so only effects that pick at random make applying an
+// action come out differently.
+type GameState struct {
+	// HandSize is the most cards a hand holds, more drawn are burned
+	HandSize int
+	// Round counts the times every player still in has had a turn, it's the mana they get with
+	// Ruleset.ManaByRound
+	Round int
+	// Turn counts every player's turns, it's the mana they get otherwise
+	Turn int
+	// Active is the index in Players of whose turn it is
+	Active  int
+	Players []PlayerState
+	// Powered is whether the active player has used their hero power this turn
+	Powered bool
+	// Result is set once the game is over
+	Result *Result
+}
+
+// NewGameState deals the opening hands and starts the first player's turn, with nil teams it's a free
+// for all
+func (r *Rules) NewGameState(players []PlayerState, teams []int, handSize int) (GameState, []Event, error) {
+	s := GameState{HandSize: handSize, Active: -1, Players: make([]PlayerState, len(players))}
+	for i, p := range players {
+		s.Players[i] = p
+		s.Players[i].Team = i
+		if teams != nil {
+			s.Players[i].Team = teams[i]
+		}
+	}
+	return r.seated(s, func(s *GameState, rules *Rules, table *Table) error {
+		if err := deal(table.Players()); err != nil {
+			return err
+		}
+		s.Result = rules.next(s, table)
+		return nil
+	})
+}
+
+// Apply plays action on state and returns the state after it and what happened. state isn't changed,
+// when the action isn't allowed the error says why and the state is returned as it was.
+func (r *Rules) Apply(state GameState, action Action) (GameState, []Event, error) {
+	if state.Result != nil {
+		return state, nil, fmt.Errorf("%w, the game is over", errs.ErrIllegalAction)
+	}
+	return r.seated(state, func(s *GameState, rules *Rules, table *Table) error {
+		result, over, err := rules.act(s.Turn, table.Players()[s.Active], table, action, &s.Powered)
+		if err != nil {
+			return err
+		}
+		s.Result = result
+		if over && result == nil {
+			s.Result = rules.next(s, table)
+		}
+		return nil
+	})
+}
+
+// LegalActions is everything the active player can do, in a stable order
+func (r *Rules) LegalActions(state GameState) []Action {
+	if state.Result != nil {
+		return nil
+	}
+	p := state.ActivePlayer()
+	aims := func(targeted bool) []string {
+		if !targeted {
+			return []string{""}
+		}
+		ids := []string{}
+		for _, o := range state.opponents(state.Active) {
+			ids = append(ids, state.Players[o].ID)
+		}
+		return ids
+	}
+	actions := []Action{}
+	for i, card := range p.Hand {
+		if r.cost(card) > p.Mana {
+			continue
+		}
+		for _, aim := range aims(r.targeted(card)) {
+			actions = append(actions, PlayCard(p.ID, i, aim))
+		}
+	}
+	if p.Power != nil && !state.Powered && p.Power.Cost <= p.Mana {
+		for _, aim := range aims(p.Power.Targeted) {
+			actions = append(actions, HeroPower(p.ID, aim))
+		}
+	}
+	return append(actions, EndTurn(p.ID), Concede(p.ID))
+}
+
+// seated plays f on a copy of state, its players seated at a table that prints nothing and the rules
+// holding their secrets. It returns the copy and the events f recorded, or state as it was if f fails.
+func (r *Rules) seated(state GameState, f func(s *GameState, rules *Rules, table *Table) error) (GameState, []Event, error) {
+	s := state.Clone()
+	rules := &Rules{log: NewEventLog(), ruleset: r.ruleset, secrets: map[string][]int{}, random: r.random, quiet: true}
+	players := make([]Player, len(s.Players))
+	teams := make([]int, len(s.Players))
+	for i, p := range s.Players {
+		players[i] = &seat{state: &s, i: i, rules: rules}
+		if p.Power != nil {
+			players[i] = poweredSeat{players[i].(*seat)}
+		}
+		teams[i] = p.Team
+		rules.secrets[p.ID] = p.Secrets
+	}
+	table := NewTable(players, teams)
+	table.round = s.Round
+	table.quiet = true
+	for i, p := range s.Players {
+		if p.Out {
+			table.Eliminate(players[i])
+		}
+	}
+	if err := f(&s, rules, table); err != nil {
+		return state, nil, err
+	}
+	for i := range s.Players {
+		s.Players[i].Out = table.IsOut(players[i])
+		s.Players[i].Secrets = rules.secrets[s.Players[i].ID]
+	}
+	return s, rules.log.Events(), nil
+}
+
+// next starts the turn of the next player still in, when burn damage takes them out it moves on again
+func (r *Rules) next(s *GameState, table *Table) *Result {
+	players := table.Players()
+	for {
+		s.Active = (s.Active + 1) % len(players)
+		if s.Active == 0 {
+			s.Round++
+			table.round = s.Round
+		}
+		if table.IsOut(players[s.Active]) {
+			continue
+		}
+		s.Turn++
+		s.Powered = false
+		if result, over := r.begin(s.Turn, players[s.Active], table); result != nil || !over {
+			return result
+		}
+	}
+}
+
+// ActivePlayer is whose turn it is
+func (s GameState) ActivePlayer() PlayerState {
+	return s.Players[s.Active]
+}
+
+// Clone is a deep copy, every hand, deck and set of secrets shares one allocation so copying is cheap
+// enough for searching thousands of states
+func (s GameState) Clone() GameState {
+	c := s
+	c.Players = make([]PlayerState, len(s.Players))
+	n := 0
+	for _, p := range s.Players {
+		n += len(p.Hand) + len(p.Deck) + len(p.Secrets)
+	}
+	cards := make([]int, n)
+	for i, p := range s.Players {
+		c.Players[i] = p
+		c.Players[i].Hand, cards = carve(cards, p.Hand)
+		c.Players[i].Deck, cards = carve(cards, p.Deck)
+		c.Players[i].Secrets, cards = carve(cards, p.Secrets)
+	}
+	return c
+}
+
+// carve copies from into the front of cards, capped so appending to the copy can't run into the
+// next one
+func carve(cards, from []int) ([]int, []int) {
+	n := copy(cards, from)
+	return cards[:n:n], cards[n:]
+}
+
+// Equal compares states by value, powers and results included
+func (s GameState) Equal(o GameState) bool {
+	if s.HandSize != o.HandSize || s.Round != o.Round || s.Turn != o.Turn || s.Active != o.Active ||
+		s.Powered != o.Powered || len(s.Players) != len(o.Players) || !s.Result.equal(o.Result) {
+		return false
+	}
+	for i, p := range s.Players {
+		if !p.equal(o.Players[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p PlayerState) equal(o PlayerState) bool {
+	if p.ID != o.ID || p.Team != o.Team || p.Health != o.Health || p.Armor != o.Armor || p.Mana != o.Mana ||
+		p.Out != o.Out || !equalInts(p.Hand, o.Hand) || !equalInts(p.Deck, o.Deck) || !equalInts(p.Secrets, o.Secrets) {
+		return false
+	}
+	if p.Power == nil || o.Power == nil {
+		return p.Power == o.Power
+	}
+	return *p.Power == *o.Power
+}
+
+func (r *Result) equal(o *Result) bool {
+	if r == nil || o == nil {
+		return r == o
+	}
+	return r.Draw == o.Draw && r.Reason == o.Reason && equalStrings(r.Winners, o.Winners) && equalStrings(r.Losers, o.Losers)
+}
+
+// Hash is the same for equal states and doesn't change between runs, for transposition tables
+func (s GameState) Hash() uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	w := func(values ...int) {
+		for _, v := range values {
+			binary.LittleEndian.PutUint64(buf[:], uint64(v))
+			h.Write(buf[:])
+		}
+	}
+	ws := func(s string) {
+		w(len(s))
+		h.Write([]byte(s))
+	}
+	w(s.HandSize, s.Round, s.Turn, s.Active, boolInt(s.Powered), len(s.Players))
+	for _, p := range s.Players {
+		ws(p.ID)
+		w(p.Team, p.Health, p.Armor, p.Mana, boolInt(p.Out), len(p.Hand))
+		w(p.Hand...)
+		w(len(p.Deck))
+		w(p.Deck...)
+		w(len(p.Secrets))
+		w(p.Secrets...)
+		if p.Power != nil {
+			ws(p.Power.Name)
+			w(p.Power.Cost, boolInt(p.Power.Targeted), p.Power.Damage, p.Power.Armor, p.Power.Draw, p.Power.SelfDamage)
+		} else {
+			ws("")
+		}
+	}
+	w(boolInt(s.Result != nil))
+	if r := s.Result; r != nil {
+		ws(string(r.Reason))
+		w(boolInt(r.Draw), len(r.Winners), len(r.Losers))
+		for _, id := range append(append([]string{}, r.Winners...), r.Losers...) {
+			ws(id)
+		}
+	}
+	return h.Sum64()
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// opponents are the indices of players still in on other teams than player i
+func (s GameState) opponents(i int) []int {
+	opponents := []int{}
+	for j, p := range s.Players {
+		if !p.Out && p.Team != s.Players[i].Team {
+			opponents = append(opponents, j)
+		}
+	}
+	return opponents
+}
+
+// seat is a player's side of a game state as a Player, so the rules play states like live games. It
+// pays for cards with the ruleset's costs and prints nothing.
+type seat struct {
+	state *GameState
+	i     int
+	rules *Rules
+}
+
+func (p *seat) player() *PlayerState {
+	return &p.state.Players[p.i]
+}
+
+func (p *seat) ID() string {
+	return p.player().ID
+}
+
+func (p *seat) GetHealth() int {
+	return p.player().Health
+}
+
+func (p *seat) GetArmor() int {
+	return p.player().Armor
+}
+
+func (p *seat) GetMana() int {
+	return p.player().Mana
+}
+
+func (p *seat) IsDead() bool {
+	return p.player().Health <= 0
+}
+
+func (p *seat) SetMana(mana int) {
+	p.player().Mana = mana
+}
+
+func (p *seat) GainArmor(armor int) {
+	p.player().Armor += armor
+}
+
+// ApplyDamage takes damage off armor before health
+func (p *seat) ApplyDamage(damage int) {
+	ps := p.player()
+	absorbed := min(damage, ps.Armor)
+	ps.Armor -= absorbed
+	ps.Health -= damage - absorbed
+}
+
+func (p *seat) PrintStats() {}
+
+// Draw takes the top card of the deck, into a full hand it's burned
+func (p *seat) Draw() error {
+	ps := p.player()
+	if len(ps.Deck) == 0 {
+		return errs.ErrDeckEmpty
+	}
+	card := ps.Deck[0]
+	ps.Deck = ps.Deck[1:]
+	if len(ps.Hand) >= p.state.HandSize {
+		return fmt.Errorf("%w, %d was burned", errs.ErrHandFull, card)
+	}
+	ps.Hand = append(ps.Hand, card)
+	return nil
+}
+
+func (p *seat) PlayCard(index int) (int, error) {
+	ps := p.player()
+	if err := p.valid(index); err != nil {
+		return 0, err
+	}
+	card := ps.Hand[index]
+	cost := p.rules.cost(card)
+	if cost > ps.Mana {
+		return 0, fmt.Errorf("%w, %d costs %d and you have %d", errs.ErrNotEnoughMana, card, cost, ps.Mana)
+	}
+	ps.Mana -= cost
+	ps.Hand = append(ps.Hand[:index:index], ps.Hand[index+1:]...)
+	return card, nil
+}
+
+func (p *seat) ShowHand() []int {
+	return append([]int{}, p.player().Hand...)
+}
+
+// AddToHand drops the card when the hand is full
+func (p *seat) AddToHand(card int) {
+	if ps := p.player(); len(ps.Hand) < p.state.HandSize {
+		ps.Hand = append(ps.Hand, card)
+	}
+}
+
+// ShuffleIntoDeck puts the card at a random place in the deck
+func (p *seat) ShuffleIntoDeck(card int) {
+	ps := p.player()
+	at := p.rules.random(len(ps.Deck) + 1)
+	ps.Deck = append(append(append([]int{}, ps.Deck[:at]...), card), ps.Deck[at:]...)
+}
+
+func (p *seat) Transform(index, card int) error {
+	if err := p.valid(index); err != nil {
+		return err
+	}
+	p.player().Hand[index] = card
+	return nil
+}
+
+func (p *seat) Discard(index int) error {
+	if err := p.valid(index); err != nil {
+		return err
+	}
+	ps := p.player()
+	ps.Hand = append(ps.Hand[:index:index], ps.Hand[index+1:]...)
+	return nil
+}
+
+func (p *seat) valid(index int) error {
+	if hand := p.player().Hand; index < 0 || index >= len(hand) {
+		return fmt.Errorf("%w %d, the hand has %d cards", errs.ErrInvalidIndex, index, len(hand))
+	}
+	return nil
+}
+
+// poweredSeat is a seat whose player has a hero power
+type poweredSeat struct {
+	*seat
+}
+
+func (p poweredSeat) PowerTargeted() bool {
+	return p.player().Power.Targeted
+}
+
+func (p poweredSeat) CanUsePower() error {
+	return p.player().Power.Afford(p.seat)
+}
+
+func (p poweredSeat) UsePower(target Player) (string, error) {
+	return p.player().Power.Use(p.seat, target)
+}
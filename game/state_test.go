@@ -0,0 +1,258 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGameState(t *testing.T) {
+	players := []PlayerState{
+		{ID: "p1", Health: 30, Deck: []int{1, 2, 3, 4, 5}},
+		{ID: "p2", Health: 30, Deck: []int{6, 7, 8}},
+	}
+
+	s, events, err := NewRules(NewEventLog()).NewGameState(players, nil, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, s.Round)
+	assert.Equal(t, 1, s.Turn)
+	assert.Equal(t, "p1", s.ActivePlayer().ID)
+	assert.Equal(t, []int{1, 2, 3, 4}, s.Players[0].Hand, "dealt 3 and drew for the turn")
+	assert.Equal(t, []int{5}, s.Players[0].Deck)
+	assert.Equal(t, 1, s.Players[0].Mana)
+	assert.Equal(t, []int{6, 7, 8}, s.Players[1].Hand)
+	assert.Equal(t, 1, s.Players[1].Team)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, players[0].Deck, "the players given aren't changed")
+	assert.Equal(t, []Event{{Turn: 1, Player: "p1", Kind: EventTurnStart, Detail: "1 mana"}}, events)
+}
+
+func TestNewGameState_SmallDeck(t *testing.T) {
+	players := []PlayerState{
+		{ID: "p1", Health: 30, Deck: []int{1, 2, 3}},
+		{ID: "p2", Health: 30, Deck: []int{6, 7}},
+	}
+	_, _, err := NewRules(NewEventLog()).NewGameState(players, nil, 5)
+	assert.True(t, errors.Is(err, errs.ErrDeckEmpty))
+}
+
+func TestRules_ApplyEndTurn(t *testing.T) {
+	tests := []struct {
+		name       string
+		players    []PlayerState
+		wantActive string
+		wantTurn   int
+		wantResult *Result
+	}{
+		{
+			name: "skips players who are out",
+			players: []PlayerState{
+				{ID: "p1", Health: 5, Deck: []int{1}},
+				{ID: "p2", Health: 0, Out: true},
+				{ID: "p3", Health: 5, Deck: []int{1}},
+			},
+			wantActive: "p3",
+			wantTurn:   4,
+		},
+		{
+			name: "burn kills and passes the turn on",
+			players: []PlayerState{
+				{ID: "p1", Health: 5, Deck: []int{1}},
+				{ID: "p2", Health: 1},
+				{ID: "p3", Health: 5, Deck: []int{1}},
+			},
+			wantActive: "p3",
+			wantTurn:   5,
+		},
+		{
+			name: "burn ends the game",
+			players: []PlayerState{
+				{ID: "p1", Health: 5, Deck: []int{1}},
+				{ID: "p2", Health: 1},
+			},
+			wantActive: "p2",
+			wantTurn:   4,
+			wantResult: &Result{Winners: []string{"p1"}, Losers: []string{"p2"}, Reason: ReasonKilled},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := GameState{HandSize: 5, Round: 3, Turn: 3, Players: tt.players}
+			for i := range s.Players {
+				s.Players[i].Team = i
+			}
+			s, _, err := NewRules(NewEventLog()).Apply(s, EndTurn("p1"))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantActive, s.ActivePlayer().ID)
+			assert.Equal(t, tt.wantTurn, s.Turn)
+			assert.Equal(t, tt.wantResult, s.Result)
+		})
+	}
+}
+
+func TestRules_ApplyBurnsInAFullHand(t *testing.T) {
+	s := GameState{HandSize: 1, Round: 1, Turn: 1, Players: []PlayerState{
+		{ID: "p1", Health: 5, Deck: []int{1}},
+		{ID: "p2", Team: 1, Health: 5, Hand: []int{1}, Deck: []int{2, 3}},
+	}}
+	s, _, err := NewRules(NewEventLog()).Apply(s, EndTurn("p1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, s.Players[1].Hand, "the drawn card was burned")
+	assert.Equal(t, []int{3}, s.Players[1].Deck)
+}
+
+func TestRules_ApplyRuleset(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(s *GameState)
+		action  Action
+		check   func(t *testing.T, s GameState)
+		wantErr error
+	}{
+		{
+			name:   "secret",
+			setup:  func(s *GameState) { s.Players[0].Hand = []int{11} },
+			action: PlayCard("p1", 0, ""),
+			check: func(t *testing.T, s GameState) {
+				assert.Equal(t, []int{11}, s.Players[0].Secrets)
+				assert.Equal(t, 1, s.Players[1].Armor, "a secret deals no damage")
+			},
+		},
+		{
+			name:   "springs a secret",
+			setup:  func(s *GameState) { s.Players[1].Secrets = []int{11} },
+			action: PlayCard("p1", 0, ""),
+			check: func(t *testing.T, s GameState) {
+				assert.Equal(t, 1, s.Players[1].Armor, "the card was countered")
+				assert.Empty(t, s.Players[1].Secrets)
+				assert.Equal(t, []int{5, 1}, s.Players[0].Hand)
+			},
+		},
+		{
+			name:   "effect at its cost",
+			setup:  func(s *GameState) { s.Players[0].Hand = []int{20} },
+			action: PlayCard("p1", 0, ""),
+			check: func(t *testing.T, s GameState) {
+				assert.Equal(t, 2, s.Players[0].Mana)
+				assert.Equal(t, []int{1}, s.Players[0].Hand, "the gift was added")
+			},
+		},
+		{
+			name:   "targeted effect",
+			setup:  func(s *GameState) { s.Players[0].Hand = []int{21}; s.Players[1].Health = 10 },
+			action: PlayCard("p1", 0, "p2"),
+			check: func(t *testing.T, s GameState) {
+				assert.Equal(t, 7, s.Players[1].Health)
+				assert.Equal(t, 2, s.Players[0].Mana)
+			},
+		},
+		{
+			name:    "effect too expensive",
+			setup:   func(s *GameState) { s.Players[0].Hand = []int{20}; s.Players[0].Mana = 0 },
+			action:  PlayCard("p1", 0, ""),
+			wantErr: errs.ErrNotEnoughMana,
+		},
+		{
+			name:   "extra mana",
+			setup:  func(s *GameState) {},
+			action: EndTurn("p1"),
+			check: func(t *testing.T, s GameState) {
+				assert.Equal(t, 6, s.Players[1].Mana)
+			},
+		},
+	}
+	ruleset := DefaultRuleset()
+	ruleset.Secrets = testSecrets
+	ruleset.Effects = testEffects
+	ruleset.ExtraMana = map[string]int{"p2": 2}
+	ruleset.Cost = func(card int) int {
+		if card > 10 {
+			return 1
+		}
+		return card
+	}
+	rules := NewRulesWith(NewEventLog(), ruleset)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := midGame()
+			tt.setup(&s)
+			s, _, err := rules.Apply(s, tt.action)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			tt.check(t, s)
+		})
+	}
+}
+
+func TestRules_LegalActionsRuleset(t *testing.T) {
+	ruleset := DefaultRuleset()
+	ruleset.Secrets = testSecrets
+	ruleset.Effects = testEffects
+	ruleset.Cost = func(card int) int { return 1 }
+	s := midGame()
+	s.Players[0].Hand = []int{11, 20, 21}
+	s.Players[0].Mana = 1
+	assert.Equal(t, []Action{
+		PlayCard("p1", 0, ""),
+		PlayCard("p1", 1, ""),
+		PlayCard("p1", 2, "p2"),
+		EndTurn("p1"),
+		Concede("p1"),
+	}, NewRulesWith(NewEventLog(), ruleset).LegalActions(s))
+}
+
+func TestGameState_Clone(t *testing.T) {
+	s := midGame()
+	c := s.Clone()
+	assert.True(t, s.Equal(c))
+	assert.Equal(t, s.Hash(), c.Hash())
+
+	c.Players[0].Hand[0] = 9
+	c.Players[0].Hand = append(c.Players[0].Hand, 7)
+	c.Players[1].Health--
+	assert.Equal(t, midGame(), s, "changing the clone leaves the original alone")
+	assert.Equal(t, []int{4}, c.Players[0].Deck, "appending to a hand can't spill into the deck")
+}
+
+func TestGameState_Equal(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(s *GameState)
+		want   bool
+	}{
+		{name: "same", change: func(s *GameState) {}, want: true},
+		{name: "empty and nil hands", change: func(s *GameState) { s.Players[1].Hand = nil }, want: true},
+		{name: "health", change: func(s *GameState) { s.Players[1].Health = 2 }},
+		{name: "hand order", change: func(s *GameState) { s.Players[0].Hand = []int{5, 2, 1} }},
+		{name: "deck", change: func(s *GameState) { s.Players[0].Deck = nil }},
+		{name: "power", change: func(s *GameState) { s.Players[0].Power = nil }},
+		{name: "power by value", change: func(s *GameState) { s.Players[0].Power = &PowerState{Name: "Armor Up", Cost: 2, Armor: 2} }, want: true},
+		{name: "power cost", change: func(s *GameState) { s.Players[0].Power = &PowerState{Name: "Armor Up", Cost: 1, Armor: 2} }},
+		{name: "powered", change: func(s *GameState) { s.Powered = true }},
+		{name: "result", change: func(s *GameState) { s.Result = &Result{Winners: []string{"p1"}} }},
+		{name: "hand size", change: func(s *GameState) { s.HandSize = 6 }},
+		{name: "round", change: func(s *GameState) { s.Round = 4 }},
+		{name: "secrets", change: func(s *GameState) { s.Players[1].Secrets = []int{11} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := midGame()
+			tt.change(&s)
+			assert.Equal(t, tt.want, midGame().Equal(s))
+			assert.Equal(t, tt.want, s.Equal(midGame()))
+			assert.Equal(t, tt.want, midGame().Hash() == s.Hash())
+		})
+	}
+}
+
+func TestGameState_HashIsStable(t *testing.T) {
+	// the hash is kept in transposition tables between runs, it mustn't change with the process
+	assert.Equal(t, uint64(0x20c13c9ce276d82c), midGame().Hash())
+	s, _, _ := NewRules(NewEventLog()).Apply(midGame(), EndTurn("p1"))
+	assert.NotEqual(t, midGame().Hash(), s.Hash())
+}
@@ -0,0 +1,154 @@
+package game
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// Table is everyone seated in a game, in turn order, and which team each of them is on.
+// Players are told apart by ID so wrapped players still match their seat.
+type Table struct {
+	players []Player
+	teams   map[string]int
+	out     map[string]bool
+	// round counts the times every player still in has had a turn, from 1, it's 0 until a game starts
+	round int
+	// quiet tables print nothing, for game states
+	quiet bool
+}
+
+// NewTable seats players in order, with nil teams it's a free for all
+func NewTable(players []Player, teams []int) *Table {
+	t := &Table{
+		players: players,
+		teams:   map[string]int{},
+		out:     map[string]bool{},
+	}
+	for i, p := range players {
+		if teams == nil {
+			t.teams[p.ID()] = i
+		} else {
+			t.teams[p.ID()] = teams[i]
+		}
+	}
+	return t
+}
+
+func (t *Table) Round() int {
+	return t.round
+}
+
+func (t *Table) Players() []Player {
+	return t.players
+}
+
+// Alive returns the players who haven't been eliminated
+func (t *Table) Alive() []Player {
+	alive := []Player{}
+	for _, p := range t.players {
+		if !t.out[p.ID()] {
+			alive = append(alive, p)
+		}
+	}
+	return alive
+}
+
+// Opponents returns the players still in the game on other teams than p
+func (t *Table) Opponents(p Player) []Player {
+	opponents := []Player{}
+	for _, o := range t.Alive() {
+		if t.teams[o.ID()] != t.teams[p.ID()] {
+			opponents = append(opponents, o)
+		}
+	}
+	return opponents
+}
+
+func (t *Table) IsOut(p Player) bool {
+	return t.out[p.ID()]
+}
+
+// Eliminate takes p out of the turn rotation
+func (t *Table) Eliminate(p Player) {
+	t.out[p.ID()] = true
+}
+
+// Settle eliminates anyone who has died and returns the result if that ends the game
+func (t *Table) Settle(reason Reason) *Result {
+	return t.outcome(t.removeDead(), reason)
+}
+
+// removeDead eliminates everyone who has died and returns them
+func (t *Table) removeDead() []Player {
+	dead := []Player{}
+	for _, p := range t.Alive() {
+		if p.IsDead() {
+			fmt.Fprintln(t.output(), p.ID(), "Is Dead!")
+			dead = append(dead, p)
+		}
+	}
+	for _, p := range dead {
+		t.Eliminate(p)
+	}
+	return dead
+}
+
+// outcome ends the game once at most one team is left, if the last players were taken out
+// together their teams tie
+func (t *Table) outcome(lastOut []Player, reason Reason) *Result {
+	teams := map[int]bool{}
+	for _, p := range t.Alive() {
+		teams[t.teams[p.ID()]] = true
+	}
+	switch len(teams) {
+	case 0:
+		for _, p := range lastOut {
+			teams[t.teams[p.ID()]] = true
+		}
+		result := t.split(teams, reason)
+		result.Draw = true
+		if reason == ReasonKilled {
+			result.Reason = ReasonSimultaneousDeath
+		}
+		fmt.Fprintf(t.output(), "%s are all out! It's a draw\n", strings.Join(result.Winners, ", "))
+		return result
+	case 1:
+		result := t.split(teams, reason)
+		fmt.Fprintln(t.output(), strings.Join(result.Winners, ", "), "WINS!")
+		return result
+	}
+	return nil
+}
+
+// agreeDraw ends the game as a tie between every team still in it
+func (t *Table) agreeDraw() *Result {
+	teams := map[int]bool{}
+	for _, p := range t.Alive() {
+		teams[t.teams[p.ID()]] = true
+	}
+	result := t.split(teams, ReasonDrawAgreed)
+	result.Draw = true
+	return result
+}
+
+// split puts every player on one of the given teams in Winners and the rest in Losers
+func (t *Table) split(teams map[int]bool, reason Reason) *Result {
+	result := &Result{Winners: []string{}, Losers: []string{}, Reason: reason}
+	for _, p := range t.players {
+		if teams[t.teams[p.ID()]] {
+			result.Winners = append(result.Winners, p.ID())
+		} else {
+			result.Losers = append(result.Losers, p.ID())
+		}
+	}
+	return result
+}
+
+func (t *Table) output() io.Writer {
+	if t.quiet {
+		return ioutil.Discard
+	}
+	return out
+}
@@ -0,0 +1,100 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_Opponents(t *testing.T) {
+	a1 := newMockPlayer("a1")
+	a2 := newMockPlayer("a2")
+	b1 := newMockPlayer("b1")
+	b2 := newMockPlayer("b2")
+	table := NewTable([]Player{a1, b1, a2, b2}, []int{0, 1, 0, 1})
+	table.Eliminate(b2)
+
+	assert.Equal(t, []Player{b1}, table.Opponents(a1))
+	assert.Equal(t, []Player{a1, a2}, table.Opponents(b1))
+	assert.Equal(t, []Player{a1, b1, a2}, table.Alive())
+}
+
+func TestTable_outcome(t *testing.T) {
+	tests := []struct {
+		name    string
+		teams   []int
+		dead    []bool
+		reason  Reason
+		want    *Result
+		wantOut []string
+	}{
+		{
+			name:   "nobody dead",
+			dead:   []bool{false, false, false},
+			reason: ReasonKilled,
+			want:   nil,
+		},
+		{
+			name:    "free for all goes on with two left",
+			dead:    []bool{false, true, false},
+			reason:  ReasonKilled,
+			want:    nil,
+			wantOut: []string{"p2"},
+		},
+		{
+			name:    "last one standing",
+			dead:    []bool{false, true, true},
+			reason:  ReasonKilled,
+			want:    &Result{Winners: []string{"p1"}, Losers: []string{"p2", "p3"}, Reason: ReasonKilled},
+			wantOut: []string{"p2", "p3"},
+		},
+		{
+			name:    "everyone dies together",
+			dead:    []bool{true, true, true},
+			reason:  ReasonKilled,
+			want:    &Result{Winners: []string{"p1", "p2", "p3"}, Losers: []string{}, Draw: true, Reason: ReasonSimultaneousDeath},
+			wantOut: []string{"p1", "p2", "p3"},
+		},
+		{
+			name:    "team wins with a dead teammate",
+			teams:   []int{0, 1, 0},
+			dead:    []bool{true, true, false},
+			reason:  ReasonKilled,
+			want:    &Result{Winners: []string{"p1", "p3"}, Losers: []string{"p2"}, Reason: ReasonKilled},
+			wantOut: []string{"p1", "p2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			players := []Player{}
+			for i, id := range []string{"p1", "p2", "p3"} {
+				p := newMockPlayer(id)
+				p.On("IsDead").Return(tt.dead[i])
+				players = append(players, p)
+			}
+			table := NewTable(players, tt.teams)
+			dead := table.removeDead()
+			assert.Equal(t, tt.want, table.outcome(dead, tt.reason))
+			out := []string{}
+			for _, p := range players {
+				if table.IsOut(p) {
+					out = append(out, p.ID())
+				}
+			}
+			if tt.wantOut == nil {
+				tt.wantOut = []string{}
+			}
+			assert.Equal(t, tt.wantOut, out)
+		})
+	}
+}
+
+func TestTable_agreeDraw(t *testing.T) {
+	p1 := newMockPlayer("p1")
+	p2 := newMockPlayer("p2")
+	p3 := newMockPlayer("p3")
+	table := NewTable([]Player{p1, p2, p3}, nil)
+	table.Eliminate(p3)
+	want := &Result{Winners: []string{"p1", "p2"}, Losers: []string{"p3"}, Draw: true, Reason: ReasonDrawAgreed}
+	assert.Equal(t, want, table.agreeDraw())
+}
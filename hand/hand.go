@@ -1,40 +1,168 @@
 package hand
 
 import (
-	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
 )
 
+// DefaultSize is how many cards a hand holds unless it's made bigger or smaller
+const DefaultSize = 5
+
+// HandImpl holds cards in order, every card gets an ID when it's added so it can be found again after
+// cards before it are removed and its index shifts
 type HandImpl struct {
 	cards []int
+	// ids are the IDs of cards, index for index
+	ids  []int
+	next int
+	size int
 }
 
 func NewHand() *HandImpl {
+	return NewSizedHand(DefaultSize)
+}
+
+// NewSizedHand holds at most size cards
+func NewSizedHand(size int) *HandImpl {
 	return &HandImpl{
 		cards: []int{},
+		ids:   []int{},
+		size:  size,
 	}
 }
 
-func (h *HandImpl) Add(card int) {
-	if len(h.cards) < 5 {
-		h.cards = append(h.cards, card)
+// Add drops the card when the hand is full and returns ErrHandFull
+func (h *HandImpl) Add(card int) error {
+	_, err := h.AddID(card)
+	return err
+}
+
+// AddID adds card and returns the ID it was given, the card is dropped when the hand is full
+func (h *HandImpl) AddID(card int) (int, error) {
+	if h.Full() {
+		return 0, fmt.Errorf("%w, %d was dropped", errs.ErrHandFull, card)
 	}
+	h.next++
+	h.cards = append(h.cards, card)
+	h.ids = append(h.ids, h.next)
+	return h.next, nil
+}
+
+func (h *HandImpl) Full() bool {
+	return len(h.cards) >= h.size
+}
+
+func (h *HandImpl) Len() int {
+	return len(h.cards)
+}
+
+func (h *HandImpl) Size() int {
+	return h.size
 }
 
 func (h *HandImpl) Remove(ind int) error {
-	if ind >= len(h.cards) {
-		return errors.New("Illegal index")
+	if !h.valid(ind) {
+		return h.invalid(ind)
 	}
 	h.cards = append(h.cards[:ind], h.cards[ind+1:]...)
+	h.ids = append(h.ids[:ind], h.ids[ind+1:]...)
 	return nil
 }
 
+// Show returns a copy of the cards so callers can't change the hand through it
 func (h *HandImpl) Show() []int {
-	return h.cards
+	return append([]int{}, h.cards...)
 }
 
 func (h *HandImpl) Get(i int) (int, error) {
-	if i >= len(h.cards) {
-		return 0, errors.New("Illegal index")
+	if !h.valid(i) {
+		return 0, h.invalid(i)
 	}
 	return h.cards[i], nil
 }
+
+// Replace turns the card at i into card, it keeps its ID
+func (h *HandImpl) Replace(i, card int) error {
+	if !h.valid(i) {
+		return h.invalid(i)
+	}
+	h.cards[i] = card
+	return nil
+}
+
+// ID is the ID of the card at index i
+func (h *HandImpl) ID(i int) (int, error) {
+	if !h.valid(i) {
+		return 0, h.invalid(i)
+	}
+	return h.ids[i], nil
+}
+
+// IndexOf is where the card with the given ID is now, false once it has left the hand
+func (h *HandImpl) IndexOf(id int) (int, bool) {
+	for i, v := range h.ids {
+		if v == id {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Discard removes the first copy of card, it reports whether there was one
+func (h *HandImpl) Discard(card int) bool {
+	i, ok := h.Find(func(c int) bool { return c == card })
+	if ok {
+		h.Remove(i)
+	}
+	return ok
+}
+
+// DiscardRandom removes a card picked like rand.Intn and returns it, false when the hand is empty
+func (h *HandImpl) DiscardRandom(random func(int) int) (int, bool) {
+	if len(h.cards) == 0 {
+		return 0, false
+	}
+	i := random(len(h.cards))
+	card := h.cards[i]
+	h.Remove(i)
+	return card, true
+}
+
+// Find returns the index of the first card that matches
+func (h *HandImpl) Find(match func(card int) bool) (int, bool) {
+	for i, c := range h.cards {
+		if match(c) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// SortByCost orders the hand cheapest first, cards costing the same keep their order
+func (h *HandImpl) SortByCost(cost func(card int) int) {
+	order := make([]int, len(h.cards))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return cost(h.cards[order[i]]) < cost(h.cards[order[j]])
+	})
+	cards := make([]int, len(order))
+	ids := make([]int, len(order))
+	for i, o := range order {
+		cards[i] = h.cards[o]
+		ids[i] = h.ids[o]
+	}
+	h.cards = cards
+	h.ids = ids
+}
+
+func (h *HandImpl) valid(i int) bool {
+	return i >= 0 && i < len(h.cards)
+}
+
+func (h *HandImpl) invalid(i int) error {
+	return fmt.Errorf("%w %d, the hand has %d cards", errs.ErrInvalidIndex, i, len(h.cards))
+}
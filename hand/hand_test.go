@@ -1,12 +1,22 @@
 package hand
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
+	"github.com/ShookieShookie/WorkshopImpl/errs"
 	"github.com/stretchr/testify/assert"
 )
 
+func handOf(cards ...int) *HandImpl {
+	h := NewHand()
+	for _, c := range cards {
+		h.Add(c)
+	}
+	return h
+}
+
 func TestHandImpl_Add(t *testing.T) {
 	type fields struct {
 		cards []int
@@ -15,10 +25,11 @@ func TestHandImpl_Add(t *testing.T) {
 		card int
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		args   args
-		want   []int
+		name    string
+		fields  fields
+		args    args
+		want    []int
+		wantErr error
 	}{
 		{
 			name: "empty",
@@ -38,15 +49,15 @@ func TestHandImpl_Add(t *testing.T) {
 			args: args{
 				card: 66,
 			},
-			want: []int{1, 2, 3, 4, 5},
+			want:    []int{1, 2, 3, 4, 5},
+			wantErr: errs.ErrHandFull,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := &HandImpl{
-				cards: tt.fields.cards,
-			}
-			h.Add(tt.args.card)
+			h := handOf(tt.fields.cards...)
+			err := h.Add(tt.args.card)
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
 			assert.Equal(t, tt.want, h.cards)
 		})
 	}
@@ -77,6 +88,17 @@ func TestHandImpl_Remove(t *testing.T) {
 			wantErr: false,
 			want:    []int{1, 3, 4, 5},
 		},
+		{
+			name: "remove negative",
+			fields: fields{
+				cards: []int{1, 2, 3, 4, 5},
+			},
+			args: args{
+				ind: -1,
+			},
+			wantErr: true,
+			want:    []int{1, 2, 3, 4, 5},
+		},
 		{
 			name: "remove illegal",
 			fields: fields{
@@ -91,9 +113,7 @@ func TestHandImpl_Remove(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := &HandImpl{
-				cards: tt.fields.cards,
-			}
+			h := handOf(tt.fields.cards...)
 			if err := h.Remove(tt.args.ind); (err != nil) != tt.wantErr {
 				t.Errorf("HandImpl.Remove() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -121,9 +141,7 @@ func TestHandImpl_Show(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := &HandImpl{
-				cards: tt.fields.cards,
-			}
+			h := handOf(tt.fields.cards...)
 			if got := h.Show(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("HandImpl.Show() = %v, want %v", got, tt.want)
 			}
@@ -156,6 +174,17 @@ func TestHandImpl_Get(t *testing.T) {
 			want:    2,
 			wantErr: false,
 		},
+		{
+			name: "get negative",
+			fields: fields{
+				cards: []int{1, 2, 3, 4, 5},
+			},
+			args: args{
+				i: -2,
+			},
+			want:    0,
+			wantErr: true,
+		},
 		{
 			name: "get illegal",
 			fields: fields{
@@ -170,9 +199,7 @@ func TestHandImpl_Get(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := &HandImpl{
-				cards: tt.fields.cards,
-			}
+			h := handOf(tt.fields.cards...)
 			got, err := h.Get(tt.args.i)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("HandImpl.Get() error = %v, wantErr %v", err, tt.wantErr)
@@ -185,6 +212,27 @@ func TestHandImpl_Get(t *testing.T) {
 	}
 }
 
+func TestHandImpl_Replace(t *testing.T) {
+	tests := []struct {
+		name    string
+		index   int
+		want    []int
+		wantErr bool
+	}{
+		{name: "replaces", index: 1, want: []int{1, 9, 3}},
+		{name: "past the end", index: 3, want: []int{1, 2, 3}, wantErr: true},
+		{name: "negative", index: -1, want: []int{1, 2, 3}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := handOf(1, 2, 3)
+			err := h.Replace(tt.index, 9)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, h.cards)
+		})
+	}
+}
+
 func TestNewHand(t *testing.T) {
 	tests := []struct {
 		name string
@@ -194,6 +242,8 @@ func TestNewHand(t *testing.T) {
 			name: "happy",
 			want: &HandImpl{
 				cards: []int{},
+				ids:   []int{},
+				size:  DefaultSize,
 			},
 		},
 	}
@@ -205,3 +255,80 @@ func TestNewHand(t *testing.T) {
 		})
 	}
 }
+
+func TestHandImpl_ShowCopies(t *testing.T) {
+	h := handOf(1, 2)
+	h.Show()[0] = 9
+	assert.Equal(t, []int{1, 2}, h.cards)
+}
+
+func TestNewSizedHand(t *testing.T) {
+	h := NewSizedHand(2)
+	id, err := h.AddID(4)
+	assert.Equal(t, 1, id)
+	assert.Nil(t, err)
+	id, _ = h.AddID(5)
+	assert.Equal(t, 2, id)
+	assert.True(t, h.Full())
+	_, err = h.AddID(6)
+	assert.True(t, errors.Is(err, errs.ErrHandFull))
+	assert.Equal(t, []int{4, 5}, h.cards)
+	assert.Equal(t, 2, h.Size())
+}
+
+func TestHandImpl_IndexOf(t *testing.T) {
+	h := handOf(7, 8, 9)
+	id, err := h.ID(2)
+	assert.Nil(t, err)
+	assert.Nil(t, h.Remove(0))
+	i, ok := h.IndexOf(id)
+	assert.True(t, ok)
+	assert.Equal(t, 1, i, "the card's index shifted but its ID didn't")
+	assert.Nil(t, h.Replace(1, 3))
+	i, ok = h.IndexOf(id)
+	assert.True(t, ok, "a transformed card is still the same card")
+	assert.Nil(t, h.Remove(i))
+	_, ok = h.IndexOf(id)
+	assert.False(t, ok)
+	_, err = h.ID(5)
+	assert.True(t, errors.Is(err, errs.ErrInvalidIndex))
+}
+
+func TestHandImpl_Discard(t *testing.T) {
+	h := handOf(1, 2, 1)
+	assert.True(t, h.Discard(1))
+	assert.Equal(t, []int{2, 1}, h.cards)
+	assert.False(t, h.Discard(5))
+
+	card, ok := h.DiscardRandom(func(n int) int { return n - 1 })
+	assert.True(t, ok)
+	assert.Equal(t, 1, card)
+	assert.Equal(t, []int{2}, h.cards)
+	h.DiscardRandom(func(int) int { return 0 })
+	_, ok = h.DiscardRandom(func(int) int { return 0 })
+	assert.False(t, ok)
+}
+
+func TestHandImpl_Find(t *testing.T) {
+	h := handOf(5, 2, 7)
+	i, ok := h.Find(func(c int) bool { return c < 4 })
+	assert.True(t, ok)
+	assert.Equal(t, 1, i)
+	_, ok = h.Find(func(c int) bool { return c > 8 })
+	assert.False(t, ok)
+}
+
+func TestHandImpl_SortByCost(t *testing.T) {
+	h := handOf(5, 11, 2, 3)
+	id, _ := h.ID(1)
+	cost := func(c int) int {
+		if c == 11 {
+			return 3
+		}
+		return c
+	}
+	h.SortByCost(cost)
+	assert.Equal(t, []int{2, 11, 3, 5}, h.cards, "11 costs 3 and was before the 3")
+	i, _ := h.IndexOf(id)
+	assert.Equal(t, 1, i)
+}
@@ -0,0 +1,117 @@
+package hero
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+)
+
+// Power is a class's hero power, it can be used once a turn
+type Power struct {
+	Name string
+	Cost int
+	Text string
+	// Targeted powers are aimed at an opponent
+	Targeted bool
+	// effect is what the power does, the rest of its state comes from the fields above
+	effect game.PowerState
+}
+
+// State is the power as data, it's what heroes and game states use
+func (p Power) State() *game.PowerState {
+	s := p.effect
+	s.Name = p.Name
+	s.Cost = p.Cost
+	s.Targeted = p.Targeted
+	return &s
+}
+
+type Class struct {
+	Name  string
+	Power Power
+	// Cards can only go in decks of this class, a card can belong to more than one class
+	Cards []int
+}
+
+var classes = map[string]Class{
+	"mage": {
+		Name:  "mage",
+		Power: Power{Name: "Fireblast", Cost: 2, Text: "deal 1 damage", Targeted: true, effect: game.PowerState{Damage: 1}},
+		Cards: []int{9},
+	},
+	"warrior": {
+		Name:  "warrior",
+		Power: Power{Name: "Armor Up", Cost: 2, Text: "gain 2 armor", effect: game.PowerState{Armor: 2}},
+		Cards: []int{10},
+	},
+	"warlock": {
+		Name:  "warlock",
+		Power: Power{Name: "Life Tap", Cost: 2, Text: "draw a card and take 2 damage", effect: game.PowerState{Draw: 1, SelfDamage: 2}},
+		Cards: []int{9},
+	},
+}
+
+// Names lists every class
+func Names() []string {
+	names := []string{}
+	for n := range classes {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func Lookup(name string) (Class, error) {
+	c, ok := classes[name]
+	if !ok {
+		return Class{}, fmt.Errorf("unknown class %q, choose from: %s", name, strings.Join(Names(), ", "))
+	}
+	return c, nil
+}
+
+// ClassCards is every card restricted to some class
+func ClassCards() []int {
+	seen := map[int]bool{}
+	cards := []int{}
+	for _, c := range classes {
+		for _, card := range c.Cards {
+			if !seen[card] {
+				seen[card] = true
+				cards = append(cards, card)
+			}
+		}
+	}
+	sort.Ints(cards)
+	return cards
+}
+
+// CheckDeck refuses cards restricted to other classes, an empty class name can only use neutral cards
+func CheckDeck(class string, cards []int) error {
+	allowed := map[int]bool{}
+	for _, card := range classes[class].Cards {
+		allowed[card] = true
+	}
+	for _, card := range cards {
+		if allowed[card] {
+			continue
+		}
+		if owners := owners(card); len(owners) > 0 {
+			return fmt.Errorf("%d is a %s card", card, strings.Join(owners, " or "))
+		}
+	}
+	return nil
+}
+
+func owners(card int) []string {
+	names := []string{}
+	for _, n := range Names() {
+		for _, c := range classes[n].Cards {
+			if c == card {
+				names = append(names, n)
+			}
+		}
+	}
+	return names
+}
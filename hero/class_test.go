@@ -0,0 +1,53 @@
+package hero
+
+import (
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	c, err := Lookup("mage")
+	assert.NoError(t, err)
+	assert.Equal(t, "Fireblast", c.Power.Name)
+
+	_, err = Lookup("bard")
+	assert.EqualError(t, err, `unknown class "bard", choose from: mage, warlock, warrior`)
+}
+
+func TestPower_State(t *testing.T) {
+	mage, _ := Lookup("mage")
+	warlock, _ := Lookup("warlock")
+	assert.Equal(t, &game.PowerState{Name: "Fireblast", Cost: 2, Targeted: true, Damage: 1}, mage.Power.State())
+	assert.Equal(t, &game.PowerState{Name: "Life Tap", Cost: 2, Draw: 1, SelfDamage: 2}, warlock.Power.State())
+}
+
+func TestClassCards(t *testing.T) {
+	assert.Equal(t, []int{9, 10}, ClassCards())
+}
+
+func TestCheckDeck(t *testing.T) {
+	tests := []struct {
+		name    string
+		class   string
+		cards   []int
+		wantErr string
+	}{
+		{name: "neutral cards", class: "mage", cards: []int{0, 4, 8}},
+		{name: "own class card", class: "warrior", cards: []int{1, 10}},
+		{name: "shared class card", class: "warlock", cards: []int{9}},
+		{name: "other class card", class: "mage", cards: []int{1, 10}, wantErr: "10 is a warrior card"},
+		{name: "classless", class: "", cards: []int{9}, wantErr: "9 is a mage or warlock card"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckDeck(tt.class, tt.cards)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
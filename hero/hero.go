@@ -0,0 +1,57 @@
+package hero
+
+import (
+	"fmt"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+)
+
+// Body is the player a hero's power acts on
+type Body interface {
+	game.Player
+	GetMana() int
+	ShowHand() []int
+	GainArmor(int)
+}
+
+// Hero is a player with a class and its hero power
+type Hero struct {
+	Body
+	class Class
+}
+
+func New(body Body, class Class) *Hero {
+	return &Hero{
+		Body:  body,
+		class: class,
+	}
+}
+
+func (h *Hero) Class() Class {
+	return h.class
+}
+
+func (h *Hero) PowerTargeted() bool {
+	return h.class.Power.Targeted
+}
+
+func (h *Hero) CanUsePower() error {
+	return h.class.Power.State().Afford(h)
+}
+
+// UsePower pays the power's mana cost and uses it, target is only used by targeted powers
+func (h *Hero) UsePower(target game.Player) (string, error) {
+	return h.class.Power.State().Use(h, target)
+}
+
+// PrintStats prints wherever the game is printing
+func (h *Hero) PrintStats() {
+	p := h.class.Power
+	fmt.Fprintf(game.Output(), "Class %s, hero power %s (%d mana): %s\n", h.class.Name, p.Name, p.Cost, p.Text)
+	h.Body.PrintStats()
+}
+
+// Unwrap lets the game reach the body's hand and deck
+func (h *Hero) Unwrap() game.Player {
+	return h.Body
+}
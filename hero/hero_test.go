@@ -0,0 +1,154 @@
+package hero
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/deck"
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/hand"
+	"github.com/ShookieShookie/WorkshopImpl/player"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBody(id string, mana int, cards ...int) *player.PlayerImpl {
+	d := deck.NewOrderedDeck()
+	for _, c := range cards {
+		d.Add(c)
+	}
+	return player.NewPlayer(id, 10, mana, hand.NewHand(), d)
+}
+
+func TestHero_UsePower(t *testing.T) {
+	tests := []struct {
+		name         string
+		class        string
+		mana         int
+		held         []int
+		deck         []int
+		want         string
+		wantErr      string
+		wantMana     int
+		wantHealth   int
+		wantArmor    int
+		wantHand     []int
+		wantEnemyHit int
+	}{
+		{
+			name:         "fireblast",
+			class:        "mage",
+			mana:         3,
+			want:         "uses Fireblast, 1 damage to enemy",
+			wantMana:     1,
+			wantHealth:   10,
+			wantHand:     []int{},
+			wantEnemyHit: 1,
+		},
+		{
+			name:       "armor up",
+			class:      "warrior",
+			mana:       2,
+			want:       "uses Armor Up, gains 2 armor",
+			wantHealth: 10,
+			wantArmor:  2,
+			wantHand:   []int{},
+		},
+		{
+			name:       "life tap",
+			class:      "warlock",
+			mana:       2,
+			deck:       []int{4},
+			want:       "uses Life Tap, draws a card and takes 2 damage",
+			wantHealth: 8,
+			wantHand:   []int{4},
+		},
+		{
+			name:       "life tap on an empty deck",
+			class:      "warlock",
+			mana:       2,
+			want:       "uses Life Tap, draws nothing and takes 2 damage",
+			wantHealth: 8,
+			wantHand:   []int{},
+		},
+		{
+			name:       "life tap into a full hand",
+			class:      "warlock",
+			mana:       2,
+			held:       []int{1, 2, 3, 4, 5},
+			deck:       []int{4},
+			want:       "uses Life Tap, burns a card and takes 2 damage",
+			wantHealth: 8,
+			wantHand:   []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:       "not enough mana",
+			class:      "warrior",
+			mana:       1,
+			wantErr:    "not enough mana, Armor Up costs 2 and you have 1",
+			wantMana:   1,
+			wantHealth: 10,
+			wantHand:   []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class, err := Lookup(tt.class)
+			assert.NoError(t, err)
+			body := newBody("me", tt.mana, tt.deck...)
+			for _, c := range tt.held {
+				body.AddToHand(c)
+			}
+			enemy := newBody("enemy", 0)
+			h := New(body, class)
+
+			got, err := h.UsePower(enemy)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.True(t, errors.Is(err, errs.ErrNotEnoughMana))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantMana, body.GetMana())
+			assert.Equal(t, tt.wantHealth, body.GetHealth())
+			assert.Equal(t, tt.wantArmor, body.GetArmor())
+			assert.Equal(t, tt.wantHand, body.ShowHand())
+			assert.Equal(t, 10-tt.wantEnemyHit, enemy.GetHealth())
+		})
+	}
+}
+
+func TestHero_PowerTargeted(t *testing.T) {
+	mage, _ := Lookup("mage")
+	warrior, _ := Lookup("warrior")
+	assert.True(t, New(newBody("a", 0), mage).PowerTargeted())
+	assert.False(t, New(newBody("a", 0), warrior).PowerTargeted())
+}
+
+func TestHero_CanUsePower(t *testing.T) {
+	mage, _ := Lookup("mage")
+	assert.NoError(t, New(newBody("a", 2), mage).CanUsePower())
+	assert.True(t, errors.Is(New(newBody("a", 1), mage).CanUsePower(), errs.ErrNotEnoughMana))
+}
+
+func TestHero_PrintStats(t *testing.T) {
+	out := &bytes.Buffer{}
+	game.SetOutput(out)
+	defer game.SetOutput(os.Stdout)
+	warrior, _ := Lookup("warrior")
+	New(newBody("a", 0), warrior).PrintStats()
+	assert.True(t, strings.HasPrefix(out.String(), "Class warrior, hero power Armor Up (2 mana): gain 2 armor\n"), out.String())
+}
+
+func TestHero_Unwrap(t *testing.T) {
+	mage, _ := Lookup("mage")
+	body := newBody("a", 0)
+	h, ok := game.HolderOf(New(body, mage))
+	assert.True(t, ok, "effects reach the body's hand and deck through the hero")
+	assert.True(t, h == body)
+}
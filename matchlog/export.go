@@ -0,0 +1,134 @@
+package matchlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+type Format string
+
+const (
+	Text      Format = "text"
+	JSONLines Format = "jsonl"
+	Markdown  Format = "markdown"
+)
+
+// Write exports entries in format
+func Write(w io.Writer, entries []Entry, format Format) error {
+	switch format {
+	case Text:
+		return writeText(w, entries)
+	case JSONLines:
+		return writeJSONLines(w, entries)
+	case Markdown:
+		return writeMarkdown(w, entries)
+	}
+	return fmt.Errorf("unknown match log format %q, use text, jsonl or markdown", format)
+}
+
+func writeText(w io.Writer, entries []Entry) error {
+	for _, e := range entries {
+		if _, err := fmt.Fprintf(w, "Turn %d %s\n", e.Turn, e.describe()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeJSONLines(w io.Writer, entries []Entry) error {
+	enc := json.NewEncoder(w)
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read parses a log written as JSON Lines, logs of several matches can follow each other
+func Read(r io.Reader) ([]Entry, error) {
+	entries := []Entry{}
+	lines := bufio.NewScanner(r)
+	for n := 1; lines.Scan(); n++ {
+		if strings.TrimSpace(lines.Text()) == "" {
+			continue
+		}
+		e := Entry{}
+		if err := json.Unmarshal(lines.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("line %d of the match log: %v", n, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, lines.Err()
+}
+
+func writeMarkdown(w io.Writer, entries []Entry) error {
+	if _, err := fmt.Fprint(w, "| Turn | Player | Event | Health |\n| --- | --- | --- | --- |\n"); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if _, err := fmt.Fprintf(w, "| %d | %s | %s | %s |\n", e.Turn, e.Player, e.event(), formatHealth(e.Health)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// describe is the entry as a sentence
+func (e Entry) describe() string {
+	if e.Kind == KindResult {
+		return e.event()
+	}
+	s := e.Player + " " + e.event()
+	if e.Health != nil {
+		s += ", health " + formatHealth(e.Health)
+	}
+	return s
+}
+
+// event is what happened without who it happened to
+func (e Entry) event() string {
+	switch e.Kind {
+	case KindDeal:
+		if e.Class == "" {
+			return fmt.Sprintf("is dealt %v", e.Cards)
+		}
+		return fmt.Sprintf("is dealt %v playing %s", e.Cards, e.Class)
+	case KindTurn:
+		return fmt.Sprintf("starts the turn with %d mana", e.Mana)
+	case KindDraw:
+		return fmt.Sprintf("draws %d", *e.Card)
+	case KindPlay:
+		return fmt.Sprintf("plays %d for %d mana dealing %d damage", *e.Card, e.Cost, e.Damage)
+	case KindPower:
+		if e.Damage > 0 {
+			return fmt.Sprintf("uses %s for %d mana dealing %d damage", e.Power, e.Cost, e.Damage)
+		}
+		return fmt.Sprintf("uses %s for %d mana", e.Power, e.Cost)
+	case KindFatigue:
+		return fmt.Sprintf("takes %d fatigue damage", e.Damage)
+	case KindResult:
+		if e.Draw {
+			return fmt.Sprintf("draw between %s by %s", strings.Join(e.Winners, ", "), e.Reason)
+		}
+		return fmt.Sprintf("%s won by %s", strings.Join(e.Winners, ", "), e.Reason)
+	}
+	return string(e.Kind)
+}
+
+func formatHealth(health map[string]int) string {
+	ids := make([]string, 0, len(health))
+	for id := range health {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = fmt.Sprintf("%s %d", id, health[id])
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,85 @@
+package matchlog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+)
+
+var match = []Entry{
+	{Turn: 1, Player: "p1", Kind: KindDeal, Cards: []int{3, 1}, Class: "mage"},
+	{Turn: 1, Player: "p1", Kind: KindTurn, Mana: 1},
+	{Turn: 1, Player: "p1", Kind: KindDraw, Card: card(0)},
+	{Turn: 1, Player: "p1", Kind: KindPlay, Card: card(0), Damage: 0, Health: map[string]int{"p2": 30, "p1": 30}},
+	{Turn: 1, Player: "p1", Kind: KindPower, Power: "Fireblast", Cost: 2, Damage: 1, Health: map[string]int{"p2": 29, "p1": 30}},
+	{Turn: 2, Player: "p2", Kind: KindFatigue, Damage: 1, Health: map[string]int{"p1": 30, "p2": 0}},
+	{Turn: 2, Player: "p2", Kind: KindResult, Winners: []string{"p1"}, Reason: game.ReasonKilled},
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  Format
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "text",
+			format: Text,
+			want: "Turn 1 p1 is dealt [3 1] playing mage\n" +
+				"Turn 1 p1 starts the turn with 1 mana\n" +
+				"Turn 1 p1 draws 0\n" +
+				"Turn 1 p1 plays 0 for 0 mana dealing 0 damage, health p1 30, p2 30\n" +
+				"Turn 1 p1 uses Fireblast for 2 mana dealing 1 damage, health p1 30, p2 29\n" +
+				"Turn 2 p2 takes 1 fatigue damage, health p1 30, p2 0\n" +
+				"Turn 2 p1 won by killed\n",
+		},
+		{
+			name:   "json lines",
+			format: JSONLines,
+			want: `{"turn":1,"player":"p1","kind":"deal","cards":[3,1],"class":"mage"}` + "\n" +
+				`{"turn":1,"player":"p1","kind":"turn","mana":1}` + "\n" +
+				`{"turn":1,"player":"p1","kind":"draw","card":0}` + "\n" +
+				`{"turn":1,"player":"p1","kind":"play","card":0,"health":{"p1":30,"p2":30}}` + "\n" +
+				`{"turn":1,"player":"p1","kind":"power","power":"Fireblast","cost":2,"damage":1,"health":{"p1":30,"p2":29}}` + "\n" +
+				`{"turn":2,"player":"p2","kind":"fatigue","damage":1,"health":{"p1":30,"p2":0}}` + "\n" +
+				`{"turn":2,"player":"p2","kind":"result","winners":["p1"],"reason":"killed"}` + "\n",
+		},
+		{
+			name:   "markdown",
+			format: Markdown,
+			want: "| Turn | Player | Event | Health |\n| --- | --- | --- | --- |\n" +
+				"| 1 | p1 | is dealt [3 1] playing mage |  |\n" +
+				"| 1 | p1 | starts the turn with 1 mana |  |\n" +
+				"| 1 | p1 | draws 0 |  |\n" +
+				"| 1 | p1 | plays 0 for 0 mana dealing 0 damage | p1 30, p2 30 |\n" +
+				"| 1 | p1 | uses Fireblast for 2 mana dealing 1 damage | p1 30, p2 29 |\n" +
+				"| 2 | p2 | takes 1 fatigue damage | p1 30, p2 0 |\n" +
+				"| 2 | p2 | p1 won by killed |  |\n",
+		},
+		{name: "unknown", format: "xml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			err := Write(w, match, tt.format)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, w.String())
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	w := &bytes.Buffer{}
+	Write(w, match, JSONLines)
+	twice := w.String() + "\n" + w.String()
+
+	got, err := Read(bytes.NewBufferString(twice))
+	assert.NoError(t, err)
+	assert.Equal(t, append(append([]Entry{}, match...), match...), got)
+
+	_, err = Read(bytes.NewBufferString("{}\nnot json\n"))
+	assert.EqualError(t, err, "line 2 of the match log: invalid character 'o' in literal null (expecting 'u')")
+}
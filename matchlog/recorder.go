@@ -0,0 +1,267 @@
+package matchlog
+
+import (
+	"errors"
+
+	"github.com/ShookieShookie/WorkshopImpl/command"
+	"github.com/ShookieShookie/WorkshopImpl/deck"
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/hero"
+)
+
+type Kind string
+
+const (
+	KindDeal    Kind = "deal"
+	KindTurn    Kind = "turn"
+	KindDraw    Kind = "draw"
+	KindPlay    Kind = "play"
+	KindPower   Kind = "power"
+	KindFatigue Kind = "fatigue"
+	KindResult  Kind = "result"
+)
+
+// Entry is one line of a match log, only the fields that fit its kind are set
+type Entry struct {
+	Turn   int    `json:"turn"`
+	Player string `json:"player"`
+	Kind   Kind   `json:"kind"`
+	// Mana is what the player has to spend at the start of their turn
+	Mana int `json:"mana,omitempty"`
+	// Card is the card drawn or played, nil for other kinds
+	Card *int `json:"card,omitempty"`
+	// Cards is the opening hand a player was dealt, Class the class they play, empty for none, and Deck
+	// the deck.ID of their whole deck list, empty when it can't be seen
+	Cards []int  `json:"cards,omitempty"`
+	Class string `json:"class,omitempty"`
+	Deck  string `json:"deck,omitempty"`
+	// Power is the name of the hero power used
+	Power  string `json:"power,omitempty"`
+	Cost   int    `json:"cost,omitempty"`
+	Damage int    `json:"damage,omitempty"`
+	// Health is everyone's health after a play, hero power or fatigue
+	Health  map[string]int `json:"health,omitempty"`
+	Winners []string       `json:"winners,omitempty"`
+	Draw    bool           `json:"draw,omitempty"`
+	Reason  game.Reason    `json:"reason,omitempty"`
+}
+
+// Recorder watches turns being played and keeps a log of every match
+type Recorder struct {
+	entries []Entry
+	table   *game.Table
+	active  game.Player
+	// pending is a play or hero power waiting to see how much damage it did, that's known once the
+	// game asks for input again or the turn ends
+	pending *Entry
+	// before is everyone's health and armor when pending was played
+	before map[string]int
+	// mana and held are the active player's mana and hand before a hero power, to tell what it cost
+	// and drew
+	mana int
+	held []int
+	// dealt is who has had their opening hand logged this match
+	dealt map[string]bool
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{dealt: map[string]bool{}}
+}
+
+func (r *Recorder) Entries() []Entry {
+	return r.entries
+}
+
+func (r *Recorder) Wrap(turn game.TurnFunc) game.TurnFunc {
+	return func(iter int, active game.Player, table *game.Table, getInput func() string) *game.Result {
+		r.table = table
+		r.active = active
+		p := &recordedPlayer{Player: active, recorder: r, turn: iter}
+		result := turn(iter, p, table, func() string {
+			r.settle()
+			s := getInput()
+			if cmd, err := command.Parse(s); err == nil && cmd.Verb == command.Power {
+				r.pending = &Entry{Turn: iter, Player: active.ID(), Kind: KindPower, Power: power(active)}
+				r.before, r.mana, r.held = r.toughness(), mana(active), cardsIn(active)
+			}
+			return s
+		})
+		r.settle()
+		if result != nil {
+			r.entries = append(r.entries, Entry{
+				Turn:    iter,
+				Player:  active.ID(),
+				Kind:    KindResult,
+				Winners: result.Winners,
+				Draw:    result.Draw,
+				Reason:  result.Reason,
+			})
+			r.dealt = map[string]bool{}
+		}
+		return result
+	}
+}
+
+// settle works out the damage of the pending play or hero power and logs it, along with the cards a
+// hero power drew
+func (r *Recorder) settle() {
+	e := r.pending
+	if e == nil {
+		return
+	}
+	r.pending = nil
+	drawn := []int{}
+	if e.Kind == KindPower {
+		// a power that wasn't paid for wasn't used
+		if e.Cost = r.mana - mana(r.active); e.Cost <= 0 {
+			return
+		}
+		if held := cardsIn(r.active); len(held) > len(r.held) {
+			drawn = held[len(r.held):]
+		}
+	}
+	after := r.toughness()
+	for id, before := range r.before {
+		if id != e.Player {
+			e.Damage += before - after[id]
+		}
+	}
+	e.Health = r.health()
+	r.entries = append(r.entries, *e)
+	for _, c := range drawn {
+		c := c
+		r.entries = append(r.entries, Entry{Turn: e.Turn, Player: e.Player, Kind: KindDraw, Card: &c})
+	}
+}
+
+func (r *Recorder) health() map[string]int {
+	health := map[string]int{}
+	for _, p := range r.table.Players() {
+		health[p.ID()] = p.GetHealth()
+	}
+	return health
+}
+
+// toughness is everyone's health and armor, what damage has to get through
+func (r *Recorder) toughness() map[string]int {
+	toughness := map[string]int{}
+	for _, p := range r.table.Players() {
+		toughness[p.ID()] = p.GetHealth()
+		if a, ok := find(p, func(p game.Player) bool { _, ok := p.(interface{ GetArmor() int }); return ok }); ok {
+			toughness[p.ID()] += a.(interface{ GetArmor() int }).GetArmor()
+		}
+	}
+	return toughness
+}
+
+// recordedPlayer logs what the active player draws and plays
+type recordedPlayer struct {
+	game.Player
+	recorder *Recorder
+	turn     int
+	// fatigued is set when a draw found the deck empty, the damage that follows is fatigue
+	fatigued bool
+}
+
+func (p *recordedPlayer) Unwrap() game.Player {
+	return p.Player
+}
+
+func (p *recordedPlayer) SetMana(mana int) {
+	p.Player.SetMana(mana)
+	if r := p.recorder; !r.dealt[p.ID()] {
+		r.dealt[p.ID()] = true
+		r.entries = append(r.entries, Entry{Turn: p.turn, Player: p.ID(), Kind: KindDeal, Cards: cardsIn(p.Player), Class: class(p.Player), Deck: deckID(p.Player)})
+	}
+	p.recorder.entries = append(p.recorder.entries, Entry{Turn: p.turn, Player: p.ID(), Kind: KindTurn, Mana: mana})
+}
+
+func (p *recordedPlayer) Draw() error {
+	held := cardsIn(p.Player)
+	err := p.Player.Draw()
+	if errors.Is(err, errs.ErrDeckEmpty) {
+		p.fatigued = true
+	}
+	if err != nil {
+		return err
+	}
+	if cards := cardsIn(p.Player); len(cards) > len(held) {
+		card := cards[len(cards)-1]
+		p.recorder.entries = append(p.recorder.entries, Entry{Turn: p.turn, Player: p.ID(), Kind: KindDraw, Card: &card})
+	}
+	return nil
+}
+
+func (p *recordedPlayer) ApplyDamage(damage int) {
+	p.Player.ApplyDamage(damage)
+	if p.fatigued {
+		p.fatigued = false
+		r := p.recorder
+		r.entries = append(r.entries, Entry{Turn: p.turn, Player: p.ID(), Kind: KindFatigue, Damage: damage, Health: r.health()})
+	}
+}
+
+func (p *recordedPlayer) PlayCard(index int) (int, error) {
+	before := mana(p.Player)
+	card, err := p.Player.PlayCard(index)
+	if err != nil {
+		return card, err
+	}
+	r := p.recorder
+	r.settle()
+	r.pending = &Entry{Turn: p.turn, Player: p.ID(), Kind: KindPlay, Card: &card, Cost: before - mana(p.Player)}
+	r.before = r.toughness()
+	return card, nil
+}
+
+// find looks through p and the players it wraps for one that matches
+func find(p game.Player, match func(game.Player) bool) (game.Player, bool) {
+	for {
+		if match(p) {
+			return p, true
+		}
+		w, ok := p.(game.Wrapper)
+		if !ok {
+			return nil, false
+		}
+		p = w.Unwrap()
+	}
+}
+
+func cardsIn(p game.Player) []int {
+	if h, ok := find(p, func(p game.Player) bool { _, ok := p.(interface{ ShowHand() []int }); return ok }); ok {
+		return h.(interface{ ShowHand() []int }).ShowHand()
+	}
+	return nil
+}
+
+// deckID identifies the deck list of a player who was just dealt, their hand and what's left in their deck
+func deckID(p game.Player) string {
+	d, ok := find(p, func(p game.Player) bool { _, ok := p.(interface{ DeckCards() []int }); return ok })
+	if !ok {
+		return ""
+	}
+	return deck.ID(append(cardsIn(p), d.(interface{ DeckCards() []int }).DeckCards()...))
+}
+
+func mana(p game.Player) int {
+	if m, ok := find(p, func(p game.Player) bool { _, ok := p.(interface{ GetMana() int }); return ok }); ok {
+		return m.(interface{ GetMana() int }).GetMana()
+	}
+	return 0
+}
+
+func class(p game.Player) string {
+	if h, ok := find(p, func(p game.Player) bool { _, ok := p.(*hero.Hero); return ok }); ok {
+		return h.(*hero.Hero).Class().Name
+	}
+	return ""
+}
+
+func power(p game.Player) string {
+	if h, ok := find(p, func(p game.Player) bool { _, ok := p.(*hero.Hero); return ok }); ok {
+		return h.(*hero.Hero).Class().Power.Name
+	}
+	return ""
+}
@@ -0,0 +1,135 @@
+package matchlog
+
+import (
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/command"
+	"github.com/ShookieShookie/WorkshopImpl/deck"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/hand"
+	"github.com/ShookieShookie/WorkshopImpl/hero"
+	"github.com/ShookieShookie/WorkshopImpl/player"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPlayer(id string, health int, cards ...int) *player.PlayerImpl {
+	d := deck.NewOrderedDeck()
+	for _, c := range cards {
+		d.Add(c)
+	}
+	return player.NewPlayer(id, health, 0, hand.NewHand(), d)
+}
+
+func inputs(lines ...string) func() string {
+	return func() string {
+		line := lines[0]
+		lines = lines[1:]
+		return line
+	}
+}
+
+func card(c int) *int {
+	return &c
+}
+
+func TestRecorder_Wrap(t *testing.T) {
+	warrior, _ := hero.Lookup("warrior")
+	mage, _ := hero.Lookup("mage")
+	warlock, _ := hero.Lookup("warlock")
+	tests := []struct {
+		name   string
+		active game.Player
+		enemy  game.Player
+		input  []string
+		want   []Entry
+	}{
+		{
+			name:   "draws and plays",
+			active: newPlayer("p1", 30, 2),
+			enemy:  newPlayer("p2", 30),
+			input:  []string{"play 0", "end"},
+			want: []Entry{
+				{Turn: 3, Player: "p1", Kind: KindDeal, Cards: []int{}, Deck: deck.ID([]int{2})},
+				{Turn: 3, Player: "p1", Kind: KindTurn, Mana: 3},
+				{Turn: 3, Player: "p1", Kind: KindDraw, Card: card(2)},
+				{Turn: 3, Player: "p1", Kind: KindPlay, Card: card(2), Cost: 2, Damage: 2, Health: map[string]int{"p1": 30, "p2": 28}},
+			},
+		},
+		{
+			name:   "fatigue",
+			active: hero.New(newPlayer("p1", 30), warrior),
+			enemy:  newPlayer("p2", 30),
+			input:  []string{"end"},
+			want: []Entry{
+				{Turn: 3, Player: "p1", Kind: KindDeal, Cards: []int{}, Class: "warrior", Deck: deck.ID(nil)},
+				{Turn: 3, Player: "p1", Kind: KindTurn, Mana: 3},
+				{Turn: 3, Player: "p1", Kind: KindFatigue, Damage: 1, Health: map[string]int{"p1": 29, "p2": 30}},
+			},
+		},
+		{
+			name:   "damaging hero power",
+			active: hero.New(newPlayer("p1", 30, 1), mage),
+			enemy:  newPlayer("p2", 30),
+			input:  []string{"power", "power", "end"},
+			want: []Entry{
+				{Turn: 3, Player: "p1", Kind: KindDeal, Cards: []int{}, Class: "mage", Deck: deck.ID([]int{1})},
+				{Turn: 3, Player: "p1", Kind: KindTurn, Mana: 3},
+				{Turn: 3, Player: "p1", Kind: KindDraw, Card: card(1)},
+				{Turn: 3, Player: "p1", Kind: KindPower, Power: "Fireblast", Cost: 2, Damage: 1, Health: map[string]int{"p1": 30, "p2": 29}},
+			},
+		},
+		{
+			name:   "drawing hero power",
+			active: hero.New(newPlayer("p1", 30, 1, 4), warlock),
+			enemy:  newPlayer("p2", 30),
+			input:  []string{"power", "end"},
+			want: []Entry{
+				{Turn: 3, Player: "p1", Kind: KindDeal, Cards: []int{}, Class: "warlock", Deck: deck.ID([]int{4, 1})},
+				{Turn: 3, Player: "p1", Kind: KindTurn, Mana: 3},
+				{Turn: 3, Player: "p1", Kind: KindDraw, Card: card(1)},
+				{Turn: 3, Player: "p1", Kind: KindPower, Power: "Life Tap", Cost: 2, Health: map[string]int{"p1": 28, "p2": 30}},
+				{Turn: 3, Player: "p1", Kind: KindDraw, Card: card(4)},
+			},
+		},
+		{
+			name:   "lethal",
+			active: newPlayer("p1", 30, 3),
+			enemy:  newPlayer("p2", 3),
+			input:  []string{"play 0"},
+			want: []Entry{
+				{Turn: 3, Player: "p1", Kind: KindDeal, Cards: []int{}, Deck: deck.ID([]int{3})},
+				{Turn: 3, Player: "p1", Kind: KindTurn, Mana: 3},
+				{Turn: 3, Player: "p1", Kind: KindDraw, Card: card(3)},
+				{Turn: 3, Player: "p1", Kind: KindPlay, Card: card(3), Cost: 3, Damage: 3, Health: map[string]int{"p1": 30, "p2": 0}},
+				{Turn: 3, Player: "p1", Kind: KindResult, Winners: []string{"p1"}, Reason: game.ReasonKilled},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRecorder()
+			table := game.NewTable([]game.Player{tt.active, tt.enemy}, nil)
+			r.Wrap(command.Turn(game.NewRules(game.NewEventLog())))(3, tt.active, table, inputs(tt.input...))
+			assert.Equal(t, tt.want, r.Entries())
+			if tt.want[len(tt.want)-1].Kind == KindResult {
+				return
+			}
+			r.Wrap(command.Turn(game.NewRules(game.NewEventLog())))(4, tt.active, table, inputs("end"))
+			assert.Equal(t, KindTurn, r.Entries()[len(tt.want)].Kind, "the hand is only dealt once a match")
+		})
+	}
+}
+
+func TestRecorder_NewMatch(t *testing.T) {
+	r := NewRecorder()
+	for i := 0; i < 2; i++ {
+		active, enemy := newPlayer("p1", 30, 1), newPlayer("p2", 1)
+		table := game.NewTable([]game.Player{active, enemy}, nil)
+		r.Wrap(command.Turn(game.NewRules(game.NewEventLog())))(1, active, table, inputs("play 0"))
+	}
+	kinds := []Kind{}
+	for _, e := range r.Entries() {
+		kinds = append(kinds, e.Kind)
+	}
+	assert.Equal(t, []Kind{KindDeal, KindTurn, KindDraw, KindPlay, KindResult, KindDeal, KindTurn, KindDraw, KindPlay, KindResult}, kinds)
+}
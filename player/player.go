@@ -1,32 +1,36 @@
 package player
 
 import (
-	"errors"
 	"fmt"
+
+	"github.com/ShookieShookie/WorkshopImpl/errs"
+	"github.com/ShookieShookie/WorkshopImpl/game"
 )
 
 type Hand interface {
-	Add(int)
+	Add(int) error
 	Remove(int) error
 	Get(int) (int, error)
 	Show() []int
+	Replace(int, int) error
 }
 type Deck interface {
-	Draw() int
+	Draw() (int, error)
 	Add(int)
+	Insert(int)
 }
 
 type PlayerImpl struct {
 	name        string
 	health      int
 	manaCurrent int
-	hand        Hand
-	deck        Deck
+	armor       int
+	// cost is what a card costs to play, nil when every card costs its value
+	cost func(card int) int
+	hand Hand
+	deck Deck
 }
 
-var notEnoughMana = errors.New("not enough mana")
-var noCardsInDeck = errors.New("no cards in deck")
-
 func NewPlayer(name string, health, manaCurrent int, hand Hand, deck Deck) *PlayerImpl {
 	return &PlayerImpl{
 		name:        name,
@@ -45,16 +49,28 @@ func (p *PlayerImpl) IsDead() bool {
 	return p.health <= 0
 }
 
+// ApplyDamage takes damage off armor before health
 func (p *PlayerImpl) ApplyDamage(damage int) {
-	p.health -= damage
+	absorbed := min(damage, p.armor)
+	p.armor -= absorbed
+	p.health -= damage - absorbed
+}
+
+func (p *PlayerImpl) GainArmor(armor int) {
+	p.armor += armor
 }
+
+func (p *PlayerImpl) GetArmor() int {
+	return p.armor
+}
+
+// Draw returns ErrDeckEmpty when there's nothing to draw and ErrHandFull when the card drawn was burned
 func (p *PlayerImpl) Draw() error {
-	v := p.deck.Draw()
-	if v == -1 {
-		return noCardsInDeck
+	v, err := p.deck.Draw()
+	if err != nil {
+		return err
 	}
-	p.hand.Add(v)
-	return nil
+	return p.hand.Add(v)
 }
 
 func (p *PlayerImpl) ID() string {
@@ -65,10 +81,59 @@ func (p *PlayerImpl) SetMana(mana int) {
 	p.manaCurrent = mana
 }
 
+func (p *PlayerImpl) GetMana() int {
+	return p.manaCurrent
+}
+
+func (p *PlayerImpl) ShowHand() []int {
+	return p.hand.Show()
+}
+
+// DeckCards are the cards left in the deck in no particular order, nil if the deck can't show them
+func (p *PlayerImpl) DeckCards() []int {
+	if d, ok := p.deck.(interface {
+		Len() int
+		Peek(n int) []int
+	}); ok {
+		return d.Peek(d.Len())
+	}
+	return nil
+}
+
+// PrintStats prints wherever the game is printing
 func (p *PlayerImpl) PrintStats() {
-	fmt.Printf("Current Health %d \n", p.health)
-	fmt.Printf("Current Mana %d \n", p.manaCurrent)
-	fmt.Printf("Current Hand %+v \n", p.hand.Show())
+	out := game.Output()
+	fmt.Fprintf(out, "Current Health %d \n", p.health)
+	if p.armor > 0 {
+		fmt.Fprintf(out, "Current Armor %d \n", p.armor)
+	}
+	fmt.Fprintf(out, "Current Mana %d \n", p.manaCurrent)
+	fmt.Fprintf(out, "Current Hand %+v \n", p.hand.Show())
+}
+
+// AddToHand is for cards that weren't drawn, like ones an effect generates
+func (p *PlayerImpl) AddToHand(card int) {
+	p.hand.Add(card)
+}
+
+// ShuffleIntoDeck puts a card somewhere in the deck, the deck decides where
+func (p *PlayerImpl) ShuffleIntoDeck(card int) {
+	p.deck.Insert(card)
+}
+
+// Transform turns the card at index in hand into another card
+func (p *PlayerImpl) Transform(index, card int) error {
+	return p.hand.Replace(index, card)
+}
+
+// Discard removes a card from hand without playing it
+func (p *PlayerImpl) Discard(index int) error {
+	return p.hand.Remove(index)
+}
+
+// SetCardCost is for cards that don't cost their value, like secrets
+func (p *PlayerImpl) SetCardCost(cost func(card int) int) {
+	p.cost = cost
 }
 
 // returns damage done
@@ -78,13 +143,24 @@ func (p *PlayerImpl) PlayCard(index int) (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	if v > p.manaCurrent {
-		return 0, notEnoughMana
+	cost := v
+	if p.cost != nil {
+		cost = p.cost(v)
 	}
-	p.manaCurrent -= v
+	if cost > p.manaCurrent {
+		return 0, fmt.Errorf("%w, %d costs %d and you have %d", errs.ErrNotEnoughMana, v, cost, p.manaCurrent)
+	}
+	p.manaCurrent -= cost
 	err = p.hand.Remove(index)
 	if err != nil {
 		return 0, err
 	}
 	return v, nil
 }
+
+func min(i, j int) int {
+	if i < j {
+		return i
+	}
+	return j
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/ShookieShookie/WorkshopImpl/errs"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -13,8 +14,9 @@ type mockHand struct {
 	mock.Mock
 }
 
-func (m *mockHand) Add(i int) {
-	m.Called(i)
+func (m *mockHand) Add(i int) error {
+	args := m.Called(i)
+	return args.Error(0)
 }
 func (m *mockHand) Remove(i int) error {
 	args := m.Called(i)
@@ -28,18 +30,25 @@ func (m *mockHand) Show() []int {
 	args := m.Called()
 	return args.Get(0).([]int)
 }
+func (m *mockHand) Replace(i, card int) error {
+	args := m.Called(i, card)
+	return args.Error(0)
+}
 
 type mockDeck struct {
 	mock.Mock
 }
 
-func (m *mockDeck) Draw() int {
+func (m *mockDeck) Draw() (int, error) {
 	args := m.Called()
-	return args.Get(0).(int)
+	return args.Int(0), args.Error(1)
 }
 func (m *mockDeck) Add(i int) {
 	m.Called(i)
 }
+func (m *mockDeck) Insert(i int) {
+	m.Called(i)
+}
 
 func TestPlayerImpl_GetHealth(t *testing.T) {
 	type fields struct {
@@ -127,6 +136,7 @@ func TestPlayerImpl_ApplyDamage(t *testing.T) {
 		name        string
 		health      int
 		manaCurrent int
+		armor       int
 		hand        Hand
 		deck        Deck
 	}
@@ -134,10 +144,11 @@ func TestPlayerImpl_ApplyDamage(t *testing.T) {
 		damage int
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		args   args
-		want   int
+		name      string
+		fields    fields
+		args      args
+		want      int
+		wantArmor int
 	}{
 		{
 			name: "apply",
@@ -149,6 +160,30 @@ func TestPlayerImpl_ApplyDamage(t *testing.T) {
 			},
 			want: -14,
 		},
+		{
+			name: "armor absorbs it all",
+			fields: fields{
+				health: 10,
+				armor:  4,
+			},
+			args: args{
+				damage: 3,
+			},
+			want:      10,
+			wantArmor: 1,
+		},
+		{
+			name: "armor breaks",
+			fields: fields{
+				health: 10,
+				armor:  2,
+			},
+			args: args{
+				damage: 5,
+			},
+			want:      7,
+			wantArmor: 0,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -156,27 +191,37 @@ func TestPlayerImpl_ApplyDamage(t *testing.T) {
 				name:        tt.fields.name,
 				health:      tt.fields.health,
 				manaCurrent: tt.fields.manaCurrent,
+				armor:       tt.fields.armor,
 				hand:        tt.fields.hand,
 				deck:        tt.fields.deck,
 			}
 			p.ApplyDamage(tt.args.damage)
 			assert.Equal(t, tt.want, p.health)
+			assert.Equal(t, tt.wantArmor, p.armor)
 		})
 	}
 }
 
+func TestPlayerImpl_GainArmor(t *testing.T) {
+	p := &PlayerImpl{armor: 1}
+	p.GainArmor(2)
+	assert.Equal(t, 3, p.GetArmor())
+}
+
 func TestPlayerImpl_Draw(t *testing.T) {
 	type DeckArgs struct {
 		ret int
+		err error
 	}
 	type HandArgs struct {
-		in int
+		in  int
+		err error
 	}
 	tests := []struct {
 		name     string
 		DeckArgs *DeckArgs
 		HandArgs *HandArgs
-		wantErr  bool
+		wantErr  error
 	}{
 		{
 			name: "nonempty deck ",
@@ -190,27 +235,38 @@ func TestPlayerImpl_Draw(t *testing.T) {
 		{
 			name: "empty deck",
 			DeckArgs: &DeckArgs{
-				ret: -1,
+				err: errs.ErrDeckEmpty,
 			},
 			HandArgs: nil, // inherently asserts hand is not added toname: "nonempty deck ",
-			wantErr:  true,
+			wantErr:  errs.ErrDeckEmpty,
+		},
+		{
+			name: "full hand burns the card",
+			DeckArgs: &DeckArgs{
+				ret: 1,
+			},
+			HandArgs: &HandArgs{
+				in:  1,
+				err: errs.ErrHandFull,
+			},
+			wantErr: errs.ErrHandFull,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			deck := &mockDeck{}
 			if tt.DeckArgs != nil {
-				deck.On("Draw").Return(tt.DeckArgs.ret)
+				deck.On("Draw").Return(tt.DeckArgs.ret, tt.DeckArgs.err)
 			}
 			hand := &mockHand{}
 			if tt.HandArgs != nil {
-				hand.On("Add", tt.HandArgs.in)
+				hand.On("Add", tt.HandArgs.in).Return(tt.HandArgs.err)
 			}
 			p := &PlayerImpl{
 				deck: deck,
 				hand: hand,
 			}
-			if err := p.Draw(); (err != nil) != tt.wantErr {
+			if err := p.Draw(); err != tt.wantErr {
 				t.Errorf("PlayerImpl.Draw() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			deck.AssertExpectations(t)
@@ -293,6 +349,23 @@ func TestPlayerImpl_SetMana(t *testing.T) {
 	}
 }
 
+func TestPlayerImpl_GetMana(t *testing.T) {
+	p := &PlayerImpl{
+		manaCurrent: 4,
+	}
+	assert.Equal(t, 4, p.GetMana())
+}
+
+func TestPlayerImpl_ShowHand(t *testing.T) {
+	m := &mockHand{}
+	m.On("Show").Return([]int{1, 3})
+	p := &PlayerImpl{
+		hand: m,
+	}
+	assert.Equal(t, []int{1, 3}, p.ShowHand())
+	m.AssertExpectations(t)
+}
+
 func TestPlayerImpl_PrintStats(t *testing.T) {
 	type mockShowArgs struct {
 		ret []int
@@ -321,9 +394,28 @@ func TestPlayerImpl_PrintStats(t *testing.T) {
 	}
 }
 
+func TestPlayerImpl_GeneratedCards(t *testing.T) {
+	h := &mockHand{}
+	d := &mockDeck{}
+	h.On("Add", 4).Return(nil)
+	h.On("Replace", 1, 7).Return(nil)
+	h.On("Remove", 0).Return(nil)
+	d.On("Insert", 16).Return()
+	p := &PlayerImpl{hand: h, deck: d}
+
+	p.AddToHand(4)
+	p.ShuffleIntoDeck(16)
+	assert.Nil(t, p.Transform(1, 7))
+	assert.Nil(t, p.Discard(0))
+
+	h.AssertExpectations(t)
+	d.AssertExpectations(t)
+}
+
 func TestPlayerImpl_PlayCard(t *testing.T) {
 	type fields struct {
 		manaCurrent int
+		cost        func(int) int
 	}
 	type args struct {
 		index int
@@ -393,7 +485,7 @@ func TestPlayerImpl_PlayCard(t *testing.T) {
 			HandGetArgs: HandGetArgs{
 				in:  1,
 				out: -1,
-				err: noCardsInDeck,
+				err: errs.ErrInvalidIndex,
 			},
 			HandRemoveArgs: nil,
 			want:           0,
@@ -419,6 +511,42 @@ func TestPlayerImpl_PlayCard(t *testing.T) {
 			want:    0,
 			wantErr: true,
 		},
+		{
+			name: "card with its own cost",
+			fields: fields{
+				manaCurrent: 2,
+				cost:        func(card int) int { return card - 9 },
+			},
+			args: args{
+				index: 0,
+			},
+			HandGetArgs: HandGetArgs{
+				in:  0,
+				out: 11,
+			},
+			HandRemoveArgs: &HandRemoveArgs{
+				in: 0,
+			},
+			want:    11,
+			wantErr: false,
+		},
+		{
+			name: "own cost too high",
+			fields: fields{
+				manaCurrent: 1,
+				cost:        func(card int) int { return card - 9 },
+			},
+			args: args{
+				index: 0,
+			},
+			HandGetArgs: HandGetArgs{
+				in:  0,
+				out: 11,
+			},
+			HandRemoveArgs: nil,
+			want:           0,
+			wantErr:        true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -429,6 +557,7 @@ func TestPlayerImpl_PlayCard(t *testing.T) {
 			}
 			p := &PlayerImpl{
 				manaCurrent: tt.fields.manaCurrent,
+				cost:        tt.fields.cost,
 				hand:        h,
 			}
 			got, err := p.PlayCard(tt.args.index)
@@ -444,6 +573,19 @@ func TestPlayerImpl_PlayCard(t *testing.T) {
 	}
 }
 
+func TestPlayerImpl_Errors(t *testing.T) {
+	h := &mockHand{}
+	h.On("Get", 0).Return(4, nil)
+	d := &mockDeck{}
+	d.On("Draw").Return(0, errs.ErrDeckEmpty)
+	p := &PlayerImpl{manaCurrent: 3, hand: h, deck: d}
+
+	_, err := p.PlayCard(0)
+	assert.True(t, errors.Is(err, errs.ErrNotEnoughMana))
+	assert.EqualError(t, err, "not enough mana, 4 costs 4 and you have 3")
+	assert.True(t, errors.Is(p.Draw(), errs.ErrDeckEmpty))
+}
+
 func TestNewPlayer(t *testing.T) {
 	type args struct {
 		name        string
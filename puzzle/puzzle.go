@@ -0,0 +1,155 @@
+package puzzle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ShookieShookie/WorkshopImpl/deck"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/hand"
+	"github.com/ShookieShookie/WorkshopImpl/player"
+)
+
+// Goal is what the player has to achieve in the one turn they get
+type Goal string
+
+const (
+	// Lethal is winning this turn
+	Lethal Goal = "lethal"
+	// Survive is still being alive after the opponent's next turn, they play their strongest response
+	Survive Goal = "survive"
+)
+
+const (
+	PlayerID   = "player1"
+	OpponentID = "opponent"
+	maxHand    = 5
+)
+
+// Hero is the exact state one side starts the puzzle in
+type Hero struct {
+	Health int   `json:"health"`
+	Mana   int   `json:"mana"`
+	Hand   []int `json:"hand"`
+	// Deck is drawn from the front
+	Deck []int `json:"deck"`
+}
+
+type Puzzle struct {
+	Name     string `json:"name"`
+	Goal     Goal   `json:"goal"`
+	Player   Hero   `json:"player"`
+	Opponent Hero   `json:"opponent"`
+}
+
+// Load reads puzzles from a JSON data file
+func Load(path string) ([]Puzzle, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file := struct {
+		Puzzles []Puzzle `json:"puzzles"`
+	}{}
+	if err := json.Unmarshal(b, &file); err != nil {
+		return nil, fmt.Errorf("reading puzzles %s: %v", path, err)
+	}
+	for i, p := range file.Puzzles {
+		if err := p.validate(); err != nil {
+			return nil, fmt.Errorf("puzzle %d in %s: %v", i+1, path, err)
+		}
+	}
+	return file.Puzzles, nil
+}
+
+func (p Puzzle) validate() error {
+	if p.Goal != Lethal && p.Goal != Survive {
+		return fmt.Errorf("unknown goal %q, use lethal or survive", p.Goal)
+	}
+	for _, h := range []Hero{p.Player, p.Opponent} {
+		if h.Health <= 0 {
+			return fmt.Errorf("both heroes need positive health")
+		}
+		if len(h.Hand) > maxHand {
+			return fmt.Errorf("hands hold at most %d cards, got %d", maxHand, len(h.Hand))
+		}
+	}
+	return nil
+}
+
+// Ruleset gives each hero exactly their puzzle mana whatever the turn number
+func (p Puzzle) Ruleset() game.Ruleset {
+	r := game.DefaultRuleset()
+	r.MaxMana = 0
+	r.ExtraMana = map[string]int{PlayerID: p.Player.Mana, OpponentID: p.Opponent.Mana}
+	return r
+}
+
+// Play sets up the puzzle and plays the player's turn with input, for Survive the opponent then
+// plays their strongest response. It returns whether the goal was met.
+func (p Puzzle) Play(turn game.TurnFunc, input func() string) bool {
+	p1 := newHero(PlayerID, p.Player)
+	p2 := newHero(OpponentID, p.Opponent)
+	table := game.NewTable([]game.Player{p1, p2}, nil)
+	if result := turn(1, p1, table, input); result != nil || p.Goal == Lethal {
+		return won(result)
+	}
+	response := script(strongest(afterDraw(p.Opponent), p.Opponent.Mana))
+	if result := turn(2, p2, table, response); result != nil {
+		return won(result)
+	}
+	return !table.IsOut(p1)
+}
+
+// Verify replays a solution through the game rules on a game state, which prints nothing
+func (p Puzzle) Verify(s *Solution) error {
+	rules := game.NewRulesWith(game.NewEventLog(), p.Ruleset())
+	state, err := p.State(rules)
+	for _, i := range s.Plays {
+		if err != nil {
+			break
+		}
+		state, _, err = rules.Apply(state, game.PlayCard(PlayerID, i, ""))
+	}
+	if err != nil || !p.met(rules, state) {
+		return fmt.Errorf("%s: solution %v doesn't meet the %s goal", p.Name, s.Plays, p.Goal)
+	}
+	return nil
+}
+
+func won(result *game.Result) bool {
+	if result == nil || result.Draw {
+		return false
+	}
+	for _, w := range result.Winners {
+		if w == PlayerID {
+			return true
+		}
+	}
+	return false
+}
+
+func newHero(id string, h Hero) *player.PlayerImpl {
+	hd := hand.NewHand()
+	for _, c := range h.Hand {
+		hd.Add(c)
+	}
+	d := deck.NewOrderedDeck()
+	for _, c := range h.Deck {
+		d.Add(c)
+	}
+	return player.NewPlayer(id, h.Health, 0, hd, d)
+}
+
+// script answers with a play for each hand index in turn and then ends the turn
+func script(plays []int) func() string {
+	i := 0
+	return func() string {
+		if i >= len(plays) {
+			return "end"
+		}
+		i++
+		return fmt.Sprintf("play %d", plays[i-1])
+	}
+}
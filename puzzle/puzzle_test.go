@@ -0,0 +1,140 @@
+package puzzle
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/command"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestShippedPuzzles checks every puzzle we ship has a solution that holds up under the real rules
+func TestShippedPuzzles(t *testing.T) {
+	puzzles, err := Load(filepath.Join("..", "data", "puzzles.json"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, puzzles)
+	for _, p := range puzzles {
+		t.Run(p.Name, func(t *testing.T) {
+			s, err := Solve(p)
+			assert.NoError(t, err)
+			assert.NoError(t, p.Verify(s))
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	hero := `{"health": 5, "mana": 1, "hand": [1], "deck": []}`
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `{"puzzles": [{"name": "a", "goal": "lethal", "player": ` + hero + `, "opponent": ` + hero + `}]}`,
+		},
+		{
+			name:    "not json",
+			data:    `{`,
+			wantErr: "reading puzzles",
+		},
+		{
+			name:    "unknown goal",
+			data:    `{"puzzles": [{"name": "a", "goal": "win", "player": ` + hero + `, "opponent": ` + hero + `}]}`,
+			wantErr: `unknown goal "win"`,
+		},
+		{
+			name:    "no health",
+			data:    `{"puzzles": [{"name": "a", "goal": "lethal", "player": {}, "opponent": ` + hero + `}]}`,
+			wantErr: "positive health",
+		},
+		{
+			name:    "hand too big",
+			data:    `{"puzzles": [{"name": "a", "goal": "lethal", "player": {"health": 1, "hand": [1, 1, 1, 1, 1, 1]}, "opponent": ` + hero + `}]}`,
+			wantErr: "at most 5 cards",
+		},
+	}
+	dir, err := ioutil.TempDir("", "puzzle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "puzzles.json")
+			if err := ioutil.WriteFile(path, []byte(tt.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := Load(path)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPuzzle_Play(t *testing.T) {
+	lethal := Puzzle{
+		Name:     "lethal",
+		Goal:     Lethal,
+		Player:   Hero{Health: 5, Mana: 8, Hand: []int{6, 5, 3}, Deck: []int{3}},
+		Opponent: Hero{Health: 8, Mana: 1},
+	}
+	survive := Puzzle{
+		Name:     "survive",
+		Goal:     Survive,
+		Player:   Hero{Health: 4, Mana: 2, Hand: []int{2}},
+		Opponent: Hero{Health: 3, Mana: 5, Hand: []int{5}},
+	}
+	tests := []struct {
+		name   string
+		puzzle Puzzle
+		plays  []int
+		want   bool
+	}{
+		{name: "lethal", puzzle: lethal, plays: []int{1, 1}, want: true},
+		{name: "greedy misses lethal", puzzle: lethal, plays: []int{0}, want: false},
+		{name: "opponent burns out", puzzle: survive, plays: []int{0}, want: true},
+		{name: "opponent strikes back", puzzle: survive, plays: []int{}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := game.NewRulesWith(game.NewEventLog(), tt.puzzle.Ruleset())
+			assert.Equal(t, tt.want, tt.puzzle.Play(command.Turn(rules), script(tt.plays)))
+		})
+	}
+}
+
+func TestPuzzle_Verify(t *testing.T) {
+	p := Puzzle{
+		Name:     "p",
+		Goal:     Lethal,
+		Player:   Hero{Health: 5, Mana: 3, Hand: []int{3}},
+		Opponent: Hero{Health: 3},
+	}
+	out := &bytes.Buffer{}
+	game.SetOutput(out)
+	defer game.SetOutput(os.Stdout)
+
+	assert.NoError(t, p.Verify(&Solution{Plays: []int{0}}))
+	assert.EqualError(t, p.Verify(&Solution{Plays: []int{}}), "p: solution [] doesn't meet the lethal goal")
+	assert.Empty(t, out.String(), "verifying doesn't print the games it plays")
+}
+
+func TestPuzzle_Ruleset(t *testing.T) {
+	p := Puzzle{Player: Hero{Mana: 4}, Opponent: Hero{Mana: 9}}
+	assert.Equal(t, game.Ruleset{
+		MaxMana:    0,
+		BurnDamage: 1,
+		ExtraMana:  map[string]int{PlayerID: 4, OpponentID: 9},
+	}, p.Ruleset())
+}
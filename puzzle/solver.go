@@ -0,0 +1,134 @@
+package puzzle
+
+import (
+	"errors"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+)
+
+var ErrNoSolution = errors.New("puzzle has no solution")
+
+// Solution is the hand indices to play in order, as they would be typed
+type Solution struct {
+	Plays []int
+}
+
+// Solve searches every order of plays for one that meets the puzzle's goal, playing them on a game
+// state by the puzzle's rules
+func Solve(p Puzzle) (*Solution, error) {
+	rules := game.NewRulesWith(game.NewEventLog(), p.Ruleset())
+	s, err := p.State(rules)
+	if err != nil {
+		return nil, err
+	}
+	plays, ok := p.search(rules, s)
+	if !ok {
+		return nil, ErrNoSolution
+	}
+	return &Solution{Plays: plays}, nil
+}
+
+// State is the puzzle as a game state at the start of the player's turn, once they've drawn
+func (p Puzzle) State(rules *game.Rules) (game.GameState, error) {
+	s := game.GameState{
+		HandSize: maxHand,
+		// it's the opponent's turn to end, that starts the player's
+		Active: 1,
+		Players: []game.PlayerState{
+			{ID: PlayerID, Team: 0, Health: p.Player.Health, Hand: p.Player.Hand, Deck: p.Player.Deck},
+			{ID: OpponentID, Team: 1, Health: p.Opponent.Health, Hand: p.Opponent.Hand, Deck: p.Opponent.Deck},
+		},
+	}
+	s, _, err := rules.Apply(s, game.EndTurn(OpponentID))
+	return s, err
+}
+
+// search tries the turn ending now and then every play the rules allow, depth first
+func (p Puzzle) search(rules *game.Rules, s game.GameState) ([]int, bool) {
+	if p.met(rules, s) {
+		return []int{}, true
+	}
+	if s.Result != nil {
+		return nil, false
+	}
+	for _, a := range rules.LegalActions(s) {
+		if a.Kind != game.ActionPlayCard {
+			continue
+		}
+		next, _, err := rules.Apply(s, a)
+		if err != nil {
+			continue
+		}
+		if plays, ok := p.search(rules, next); ok {
+			return append([]int{a.Card}, plays...), true
+		}
+	}
+	return nil, false
+}
+
+// met is whether the goal is met if the player ends their turn in s
+func (p Puzzle) met(rules *game.Rules, s game.GameState) bool {
+	if s.Result != nil {
+		return won(s.Result)
+	}
+	if p.Goal == Lethal {
+		return false
+	}
+	s, _, err := rules.Apply(s, game.EndTurn(PlayerID))
+	if err != nil {
+		return false
+	}
+	if s.Result != nil {
+		return won(s.Result)
+	}
+	return !kills(rules, s)
+}
+
+// kills is whether the opponent has any plays on their turn in s that kill the player
+func kills(rules *game.Rules, s game.GameState) bool {
+	for _, a := range rules.LegalActions(s) {
+		if a.Kind != game.ActionPlayCard && a.Kind != game.ActionHeroPower {
+			continue
+		}
+		next, _, err := rules.Apply(s, a)
+		if err != nil {
+			continue
+		}
+		if next.Result != nil && !won(next.Result) || next.Result == nil && kills(rules, next) {
+			return true
+		}
+	}
+	return false
+}
+
+// afterDraw is a hero's hand once the turn's draw is done, a full hand loses the card
+func afterDraw(h Hero) []int {
+	cards := append([]int{}, h.Hand...)
+	if len(h.Deck) > 0 && len(cards) < maxHand {
+		cards = append(cards, h.Deck[0])
+	}
+	return cards
+}
+
+// strongest is the hand indices to play for the most damage with mana, each card costs what it deals
+func strongest(cards []int, mana int) []int {
+	best, bestDamage := []int{}, 0
+	for set := 0; set < 1<<uint(len(cards)); set++ {
+		chosen, damage := []int{}, 0
+		for i, c := range cards {
+			if set&(1<<uint(i)) != 0 {
+				chosen = append(chosen, i)
+				damage += c
+			}
+		}
+		if damage <= mana && damage > bestDamage {
+			best, bestDamage = chosen, damage
+		}
+	}
+	// play from the back so earlier indices stay put
+	plays := make([]int, len(best))
+	for i, c := range best {
+		plays[len(best)-1-i] = c
+	}
+	return plays
+}
@@ -0,0 +1,119 @@
+package puzzle
+
+import (
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		name    string
+		puzzle  Puzzle
+		want    *Solution
+		wantErr error
+	}{
+		{
+			name: "lethal needs the right pair",
+			puzzle: Puzzle{
+				Goal:     Lethal,
+				Player:   Hero{Health: 5, Mana: 8, Hand: []int{6, 5, 3}, Deck: []int{3}},
+				Opponent: Hero{Health: 8},
+			},
+			want: &Solution{Plays: []int{1, 1}},
+		},
+		{
+			name: "lethal with the drawn card",
+			puzzle: Puzzle{
+				Goal:     Lethal,
+				Player:   Hero{Health: 5, Mana: 7, Hand: []int{1, 2}, Deck: []int{6}},
+				Opponent: Hero{Health: 7},
+			},
+			want: &Solution{Plays: []int{0, 1}},
+		},
+		{
+			name: "not enough damage",
+			puzzle: Puzzle{
+				Goal:     Lethal,
+				Player:   Hero{Health: 5, Mana: 3, Hand: []int{3, 3}},
+				Opponent: Hero{Health: 4},
+			},
+			wantErr: ErrNoSolution,
+		},
+		{
+			name: "burn kills the player first",
+			puzzle: Puzzle{
+				Goal:     Lethal,
+				Player:   Hero{Health: 1, Mana: 3, Hand: []int{3}},
+				Opponent: Hero{Health: 3},
+			},
+			wantErr: ErrNoSolution,
+		},
+		{
+			name: "survive when the threat is too small",
+			puzzle: Puzzle{
+				Goal:     Survive,
+				Player:   Hero{Health: 6, Mana: 0, Deck: []int{1}},
+				Opponent: Hero{Health: 9, Mana: 6, Hand: []int{1, 1, 1, 1, 1}, Deck: []int{6}},
+			},
+			want: &Solution{Plays: []int{}},
+		},
+		{
+			name: "survive by leaving the opponent to burn",
+			puzzle: Puzzle{
+				Goal:     Survive,
+				Player:   Hero{Health: 4, Mana: 2, Hand: []int{2}},
+				Opponent: Hero{Health: 3, Mana: 5, Hand: []int{5}},
+			},
+			want: &Solution{Plays: []int{0}},
+		},
+		{
+			name: "cannot survive",
+			puzzle: Puzzle{
+				Goal:     Survive,
+				Player:   Hero{Health: 4, Mana: 2, Hand: []int{2}},
+				Opponent: Hero{Health: 9, Mana: 5, Hand: []int{5}},
+			},
+			wantErr: ErrNoSolution,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Solve(tt.puzzle)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_strongest(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []int
+		mana  int
+		want  []int
+	}{
+		{name: "nothing affordable", cards: []int{4}, mana: 3, want: []int{}},
+		{name: "best fit", cards: []int{6, 5, 3}, mana: 8, want: []int{2, 1}},
+		{name: "everything", cards: []int{1, 2}, mana: 5, want: []int{1, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, strongest(tt.cards, tt.mana))
+		})
+	}
+}
+
+func TestPuzzle_State(t *testing.T) {
+	p := Puzzle{
+		Player:   Hero{Health: 5, Mana: 4, Hand: []int{1}, Deck: []int{2, 3}},
+		Opponent: Hero{Health: 7, Mana: 9},
+	}
+	s, err := p.State(game.NewRulesWith(game.NewEventLog(), p.Ruleset()))
+	assert.NoError(t, err)
+	assert.Equal(t, PlayerID, s.ActivePlayer().ID)
+	assert.Equal(t, 4, s.ActivePlayer().Mana, "the puzzle mana whatever the turn")
+	assert.Equal(t, []int{1, 2}, s.ActivePlayer().Hand, "the turn's card is drawn")
+	assert.Equal(t, []int{3}, s.ActivePlayer().Deck)
+}
@@ -0,0 +1,111 @@
+package rating
+
+import (
+	"math"
+)
+
+const (
+	defaultRating     = 1500.0
+	defaultDeviation  = 350.0
+	defaultVolatility = 0.06
+	// glicko2Scale converts between the Glicko and Glicko-2 scales
+	glicko2Scale = 173.7178
+	convergence  = 0.000001
+)
+
+type Rating struct {
+	Rating     float64 `json:"rating"`
+	Deviation  float64 `json:"deviation"`
+	Volatility float64 `json:"volatility"`
+}
+
+// Outcome is a single game against an opponent, Score is 1 for a win, 0.5 for a draw and 0 for a loss
+type Outcome struct {
+	Opponent Rating
+	Score    float64
+}
+
+func NewRating() Rating {
+	return Rating{
+		Rating:     defaultRating,
+		Deviation:  defaultDeviation,
+		Volatility: defaultVolatility,
+	}
+}
+
+// Update runs a single Glicko-2 rating period, tau constrains how fast volatility changes
+func Update(r Rating, outcomes []Outcome, tau float64) Rating {
+	mu := (r.Rating - defaultRating) / glicko2Scale
+	phi := r.Deviation / glicko2Scale
+	if len(outcomes) == 0 {
+		return Rating{
+			Rating:     r.Rating,
+			Deviation:  math.Min(math.Sqrt(phi*phi+r.Volatility*r.Volatility)*glicko2Scale, defaultDeviation),
+			Volatility: r.Volatility,
+		}
+	}
+	vInv := 0.0
+	improvement := 0.0
+	for _, o := range outcomes {
+		muJ := (o.Opponent.Rating - defaultRating) / glicko2Scale
+		gJ := g(o.Opponent.Deviation / glicko2Scale)
+		e := expected(mu, muJ, gJ)
+		vInv += gJ * gJ * e * (1 - e)
+		improvement += gJ * (o.Score - e)
+	}
+	v := 1 / vInv
+	delta := v * improvement
+	sigma := volatility(phi, r.Volatility, v, delta, tau)
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	phiNew := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	muNew := mu + phiNew*phiNew*improvement
+	return Rating{
+		Rating:     muNew*glicko2Scale + defaultRating,
+		Deviation:  phiNew * glicko2Scale,
+		Volatility: sigma,
+	}
+}
+
+func g(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+func expected(mu, muJ, gJ float64) float64 {
+	return 1 / (1 + math.Exp(-gJ*(mu-muJ)))
+}
+
+// volatility finds the new volatility with the Illinois algorithm from step 5 of the Glicko-2 paper
+func volatility(phi, sigma, v, delta, tau float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-d)/(2*d*d) - (x-a)/(tau*tau)
+	}
+	upper := a
+	var lower float64
+	if delta*delta > phi*phi+v {
+		lower = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*tau) < 0 {
+			k++
+		}
+		lower = a - k*tau
+	}
+	fUpper := f(upper)
+	fLower := f(lower)
+	for math.Abs(lower-upper) > convergence {
+		c := upper + (upper-lower)*fUpper/(fLower-fUpper)
+		fc := f(c)
+		if fc*fLower <= 0 {
+			upper = lower
+			fUpper = fLower
+		} else {
+			fUpper /= 2
+		}
+		lower = c
+		fLower = fc
+	}
+	return math.Exp(upper / 2)
+}
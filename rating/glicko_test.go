@@ -0,0 +1,83 @@
+package rating
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		r        Rating
+		outcomes []Outcome
+	}
+	tests := []struct {
+		name string
+		args args
+		want Rating
+	}{
+		{
+			name: "example from the glicko-2 paper",
+			args: args{
+				r: Rating{Rating: 1500, Deviation: 200, Volatility: 0.06},
+				outcomes: []Outcome{
+					{Opponent: Rating{Rating: 1400, Deviation: 30}, Score: 1},
+					{Opponent: Rating{Rating: 1550, Deviation: 100}, Score: 0},
+					{Opponent: Rating{Rating: 1700, Deviation: 300}, Score: 0},
+				},
+			},
+			want: Rating{Rating: 1464.06, Deviation: 151.52, Volatility: 0.05999},
+		},
+		{
+			name: "no games only widens deviation",
+			args: args{
+				r:        Rating{Rating: 1500, Deviation: 200, Volatility: 0.06},
+				outcomes: nil,
+			},
+			want: Rating{Rating: 1500, Deviation: 200.27, Volatility: 0.06},
+		},
+		{
+			name: "deviation never exceeds the default",
+			args: args{
+				r:        NewRating(),
+				outcomes: nil,
+			},
+			want: NewRating(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Update(tt.args.r, tt.args.outcomes, tau)
+			assert.InDelta(t, tt.want.Rating, got.Rating, 0.01)
+			assert.InDelta(t, tt.want.Deviation, got.Deviation, 0.01)
+			assert.InDelta(t, tt.want.Volatility, got.Volatility, 0.00001)
+		})
+	}
+}
+
+func Test_g(t *testing.T) {
+	tests := []struct {
+		name string
+		phi  float64
+		want float64
+	}{
+		{
+			name: "certain rating has full weight",
+			phi:  0,
+			want: 1,
+		},
+		{
+			name: "uncertain rating has less weight",
+			phi:  300 / glicko2Scale,
+			want: 0.7242,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := g(tt.phi); math.Abs(got-tt.want) > 0.0001 {
+				t.Errorf("g() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package rating
+
+import (
+	"sort"
+)
+
+// tau is the Glicko-2 system constant, smaller values keep volatility steadier
+const tau = 0.5
+
+type Entry struct {
+	ID     string `json:"id"`
+	Rating Rating `json:"rating"`
+	Wins   int    `json:"wins"`
+	Losses int    `json:"losses"`
+	Draws  int    `json:"draws"`
+}
+
+type Ladder struct {
+	Season  int               `json:"season"`
+	Players map[string]*Entry `json:"players"`
+}
+
+func NewLadder() *Ladder {
+	return &Ladder{
+		Season:  1,
+		Players: map[string]*Entry{},
+	}
+}
+
+func (l *Ladder) entry(id string) *Entry {
+	e, ok := l.Players[id]
+	if !ok {
+		e = &Entry{ID: id, Rating: NewRating()}
+		l.Players[id] = e
+	}
+	return e
+}
+
+// RecordMatch rates a single game between two players
+func (l *Ladder) RecordMatch(winner, loser string, draw bool) {
+	if draw {
+		l.RecordResult([]string{winner, loser}, nil, true)
+		return
+	}
+	l.RecordResult([]string{winner}, []string{loser}, false)
+}
+
+// RecordResult treats every game as its own rating period so the ladder moves after each one.
+// Every winner beat every loser, when it's a draw the winners tied with each other.
+func (l *Ladder) RecordResult(winners, losers []string, draw bool) {
+	before := map[string]Rating{}
+	for _, id := range winners {
+		before[id] = l.entry(id).Rating
+	}
+	for _, id := range losers {
+		before[id] = l.entry(id).Rating
+	}
+	outcomes := map[string][]Outcome{}
+	for _, w := range winners {
+		for _, lo := range losers {
+			outcomes[w] = append(outcomes[w], Outcome{Opponent: before[lo], Score: 1})
+			outcomes[lo] = append(outcomes[lo], Outcome{Opponent: before[w], Score: 0})
+		}
+		if draw {
+			l.Players[w].Draws++
+			for _, o := range winners {
+				if o != w {
+					outcomes[w] = append(outcomes[w], Outcome{Opponent: before[o], Score: 0.5})
+				}
+			}
+		} else {
+			l.Players[w].Wins++
+		}
+	}
+	for _, lo := range losers {
+		l.Players[lo].Losses++
+	}
+	for id, o := range outcomes {
+		l.Players[id].Rating = Update(before[id], o, tau)
+	}
+}
+
+// Leaderboard returns entries sorted best first
+func (l *Ladder) Leaderboard() []Entry {
+	board := make([]Entry, 0, len(l.Players))
+	for _, e := range l.Players {
+		board = append(board, *e)
+	}
+	sort.Slice(board, func(i, j int) bool {
+		if board[i].Rating.Rating != board[j].Rating.Rating {
+			return board[i].Rating.Rating > board[j].Rating.Rating
+		}
+		return board[i].ID < board[j].ID
+	})
+	return board
+}
+
+// NewSeason pulls every rating toward the default by factor (0 keeps ratings, 1 resets fully)
+// and widens deviations the same way, records are cleared
+func (l *Ladder) NewSeason(factor float64) {
+	l.Season++
+	for _, e := range l.Players {
+		e.Rating.Rating += (defaultRating - e.Rating.Rating) * factor
+		e.Rating.Deviation += (defaultDeviation - e.Rating.Deviation) * factor
+		e.Wins = 0
+		e.Losses = 0
+		e.Draws = 0
+	}
+}
@@ -0,0 +1,141 @@
+package rating
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLadder(t *testing.T) {
+	want := &Ladder{Season: 1, Players: map[string]*Entry{}}
+	if got := NewLadder(); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewLadder() = %v, want %v", got, want)
+	}
+}
+
+func TestLadder_RecordMatch(t *testing.T) {
+	type args struct {
+		winner string
+		loser  string
+		draw   bool
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantWinner Entry
+		wantLoser  Entry
+	}{
+		{
+			name: "win moves ratings apart",
+			args: args{winner: "player1", loser: "player2"},
+			wantWinner: Entry{
+				ID:     "player1",
+				Rating: Rating{Rating: 1662.31, Deviation: 290.32, Volatility: 0.06},
+				Wins:   1,
+			},
+			wantLoser: Entry{
+				ID:     "player2",
+				Rating: Rating{Rating: 1337.69, Deviation: 290.32, Volatility: 0.06},
+				Losses: 1,
+			},
+		},
+		{
+			name: "draw between equals keeps ratings",
+			args: args{winner: "player1", loser: "player2", draw: true},
+			wantWinner: Entry{
+				ID:     "player1",
+				Rating: Rating{Rating: 1500, Deviation: 290.32, Volatility: 0.06},
+				Draws:  1,
+			},
+			wantLoser: Entry{
+				ID:     "player2",
+				Rating: Rating{Rating: 1500, Deviation: 290.32, Volatility: 0.06},
+				Draws:  1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLadder()
+			l.RecordMatch(tt.args.winner, tt.args.loser, tt.args.draw)
+			for _, want := range []Entry{tt.wantWinner, tt.wantLoser} {
+				got := l.Players[want.ID]
+				assert.Equal(t, want.Wins, got.Wins)
+				assert.Equal(t, want.Losses, got.Losses)
+				assert.Equal(t, want.Draws, got.Draws)
+				assert.InDelta(t, want.Rating.Rating, got.Rating.Rating, 0.01)
+				assert.InDelta(t, want.Rating.Deviation, got.Rating.Deviation, 0.01)
+				assert.InDelta(t, want.Rating.Volatility, got.Rating.Volatility, 0.0001)
+			}
+		})
+	}
+}
+
+func TestLadder_RecordResult(t *testing.T) {
+	l := NewLadder()
+	l.RecordResult([]string{"a1", "a2"}, []string{"b1", "b2"}, false)
+	for _, id := range []string{"a1", "a2"} {
+		assert.Equal(t, 1, l.Players[id].Wins)
+		assert.True(t, l.Players[id].Rating.Rating > 1500)
+	}
+	for _, id := range []string{"b1", "b2"} {
+		assert.Equal(t, 1, l.Players[id].Losses)
+		assert.True(t, l.Players[id].Rating.Rating < 1500)
+	}
+	assert.InDelta(t, l.Players["a1"].Rating.Rating, l.Players["a2"].Rating.Rating, 0.0001)
+
+	l.RecordResult([]string{"a1", "b1", "c1"}, []string{"b2"}, true)
+	assert.Equal(t, 1, l.Players["c1"].Draws)
+	assert.Equal(t, 2, l.Players["b2"].Losses)
+	assert.True(t, l.Players["c1"].Rating.Rating > 1500, "a draw among the winners still beat the loser")
+}
+
+func TestLadder_Leaderboard(t *testing.T) {
+	l := &Ladder{
+		Players: map[string]*Entry{
+			"b": {ID: "b", Rating: Rating{Rating: 1500}},
+			"a": {ID: "a", Rating: Rating{Rating: 1500}},
+			"c": {ID: "c", Rating: Rating{Rating: 1700}},
+		},
+	}
+	var ids []string
+	for _, e := range l.Leaderboard() {
+		ids = append(ids, e.ID)
+	}
+	assert.Equal(t, []string{"c", "a", "b"}, ids)
+}
+
+func TestLadder_NewSeason(t *testing.T) {
+	type args struct {
+		factor float64
+	}
+	tests := []struct {
+		name  string
+		entry Entry
+		args  args
+		want  Entry
+	}{
+		{
+			name:  "soft reset halfway",
+			entry: Entry{ID: "a", Rating: Rating{Rating: 1700, Deviation: 50, Volatility: 0.06}, Wins: 3, Losses: 1, Draws: 1},
+			args:  args{factor: 0.5},
+			want:  Entry{ID: "a", Rating: Rating{Rating: 1600, Deviation: 200, Volatility: 0.06}},
+		},
+		{
+			name:  "hard reset",
+			entry: Entry{ID: "a", Rating: Rating{Rating: 1300, Deviation: 50, Volatility: 0.06}, Losses: 4},
+			args:  args{factor: 1},
+			want:  Entry{ID: "a", Rating: Rating{Rating: 1500, Deviation: 350, Volatility: 0.06}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := tt.entry
+			l := &Ladder{Season: 1, Players: map[string]*Entry{e.ID: &e}}
+			l.NewSeason(tt.args.factor)
+			assert.Equal(t, 2, l.Season)
+			assert.Equal(t, tt.want, *l.Players[e.ID])
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package rating
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+)
+
+type FileStore struct {
+	path string
+}
+
+func NewFileStore(path string) *FileStore {
+	return &FileStore{
+		path: path,
+	}
+}
+
+// Load returns an empty ladder when the file doesn't exist yet
+func (s *FileStore) Load() (*Ladder, error) {
+	b, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return NewLadder(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	l := NewLadder()
+	if err := json.Unmarshal(b, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (s *FileStore) Save(l *Ladder) error {
+	b, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(s.path, b, 0644)
+}
@@ -0,0 +1,43 @@
+package rating
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ladder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s := NewFileStore(filepath.Join(dir, "ladder.json"))
+
+	l, err := s.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, NewLadder(), l, "missing file gives an empty ladder")
+
+	l.RecordMatch("player1", "player2", false)
+	assert.NoError(t, s.Save(l))
+	got, err := s.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, l, got)
+}
+
+func TestFileStore_LoadCorrupt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ladder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "ladder.json")
+	if err := ioutil.WriteFile(path, []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewFileStore(path).Load()
+	assert.Error(t, err)
+}
@@ -0,0 +1,54 @@
+package secret
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+)
+
+type card struct {
+	secret game.Secret
+	cost   int
+}
+
+// cards are numbered past the damage cards so they can't be mistaken for them
+var cards = map[int]card{
+	11: {cost: 3, secret: game.Secret{Name: "Counterspell", Trigger: game.TriggerCardPlayed, Counters: true, Spring: func(owner, opponent game.Player, damage int) (int, string) {
+		return 0, fmt.Sprintf("counters %s's card", opponent.ID())
+	}}},
+	12: {cost: 2, secret: game.Secret{Name: "Explosive Trap", Trigger: game.TriggerAttacked, Spring: func(owner, opponent game.Player, damage int) (int, string) {
+		opponent.ApplyDamage(2)
+		return damage, fmt.Sprintf("2 damage to %s", opponent.ID())
+	}}},
+	13: {cost: 3, secret: game.Secret{Name: "Ice Block", Trigger: game.TriggerLethal, Spring: func(owner, opponent game.Player, damage int) (int, string) {
+		return 0, fmt.Sprintf("blocks %d lethal damage", damage)
+	}}},
+}
+
+// Cards are the secrets by card value, for the game's ruleset
+func Cards() map[int]game.Secret {
+	secrets := map[int]game.Secret{}
+	for value, c := range cards {
+		secrets[value] = c.secret
+	}
+	return secrets
+}
+
+// Values lists the secret cards in order
+func Values() []int {
+	values := []int{}
+	for v := range cards {
+		values = append(values, v)
+	}
+	sort.Ints(values)
+	return values
+}
+
+// Cost is what a card costs to play, secrets have their own costs and every other card costs its value
+func Cost(value int) int {
+	if c, ok := cards[value]; ok {
+		return c.cost
+	}
+	return value
+}
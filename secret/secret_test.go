@@ -0,0 +1,57 @@
+package secret
+
+import (
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+)
+
+type target struct {
+	id     string
+	health int
+}
+
+func (t *target) ApplyDamage(d int)         { t.health -= d }
+func (t *target) GetHealth() int            { return t.health }
+func (t *target) SetMana(int)               {}
+func (t *target) PlayCard(int) (int, error) { return 0, nil }
+func (t *target) IsDead() bool              { return t.health <= 0 }
+func (t *target) Draw() error               { return nil }
+func (t *target) ID() string                { return t.id }
+func (t *target) PrintStats()               {}
+
+func TestCards(t *testing.T) {
+	tests := []struct {
+		value        int
+		trigger      game.Trigger
+		damage       int
+		wantDamage   int
+		wantText     string
+		wantOpponent int
+	}{
+		{value: 11, trigger: game.TriggerCardPlayed, damage: 5, wantDamage: 0, wantText: "counters p2's card", wantOpponent: 10},
+		{value: 12, trigger: game.TriggerAttacked, damage: 0, wantDamage: 0, wantText: "2 damage to p2", wantOpponent: 8},
+		{value: 13, trigger: game.TriggerLethal, damage: 9, wantDamage: 0, wantText: "blocks 9 lethal damage", wantOpponent: 10},
+	}
+	secrets := Cards()
+	for _, tt := range tests {
+		s := secrets[tt.value]
+		t.Run(s.Name, func(t *testing.T) {
+			owner := &target{id: "p1", health: 10}
+			opponent := &target{id: "p2", health: 10}
+			assert.Equal(t, tt.trigger, s.Trigger)
+			damage, text := s.Spring(owner, opponent, tt.damage)
+			assert.Equal(t, tt.wantDamage, damage)
+			assert.Equal(t, tt.wantText, text)
+			assert.Equal(t, tt.wantOpponent, opponent.health)
+		})
+	}
+}
+
+func TestCost(t *testing.T) {
+	assert.Equal(t, 3, Cost(11))
+	assert.Equal(t, 2, Cost(12))
+	assert.Equal(t, 5, Cost(5))
+	assert.Equal(t, []int{11, 12, 13}, Values())
+}
@@ -0,0 +1,198 @@
+package stats
+
+import (
+	"sort"
+
+	"github.com/ShookieShookie/WorkshopImpl/matchlog"
+)
+
+// Card is how a card did across matches, counted once for each player in each match
+type Card struct {
+	Card int
+	// Drawn is how many times the card was in a player's hand during a match, dealt, drawn or made
+	Drawn int
+	// Played is how many of those the card was played
+	Played int
+	// Wins is how many of the times it was drawn the player won
+	Wins int
+	// OnCurve is how many times it was played on the turn the player's mana first reached its cost
+	OnCurve     int
+	OnCurveWins int
+	// plays and turns add up every play and the player's own turn number it was played on
+	plays int
+	turns int
+}
+
+func (c Card) PlayRate() float64 {
+	return rate(c.Played, c.Drawn)
+}
+
+func (c Card) WinRateDrawn() float64 {
+	return rate(c.Wins, c.Drawn)
+}
+
+func (c Card) WinRateOnCurve() float64 {
+	return rate(c.OnCurveWins, c.OnCurve)
+}
+
+// AverageTurn is the average of the player's own turn number the card was played on, 1 for their first
+func (c Card) AverageTurn() float64 {
+	return rate(c.turns, c.plays)
+}
+
+// Record is how one deck did against another
+type Record struct {
+	Wins   int
+	Losses int
+	Draws  int
+}
+
+// Report is what every recorded match adds up to. Decks are named by their class, neutral for none,
+// and the deck.ID of their list, so two decks of one class are told apart. Logs from before deck lists
+// were recorded name them by class alone.
+type Report struct {
+	Matches int
+	Cards   []Card
+	// Matchups holds the record of each deck against each other deck
+	Matchups map[string]map[string]Record
+}
+
+// Decks are the decks in Matchups, sorted
+func (r Report) Decks() []string {
+	decks := []string{}
+	for d := range r.Matchups {
+		decks = append(decks, d)
+	}
+	sort.Strings(decks)
+	return decks
+}
+
+// seat is one player in one match
+type seat struct {
+	deck  string
+	turns int
+	// mana is what they started this turn with and before what they started their last turn with
+	mana   int
+	before int
+	// drawn are the cards that were in their hand, played the turns they were played on and onCurve the cards
+	// played on curve
+	drawn   map[int]bool
+	played  map[int][]int
+	onCurve map[int]bool
+}
+
+// Aggregate adds up matches from their logs, entries after the last result are an unfinished match and
+// are left out
+func Aggregate(entries []matchlog.Entry) Report {
+	report := Report{Matchups: map[string]map[string]Record{}}
+	cards := map[int]*Card{}
+	seats := map[string]*seat{}
+	order := []string{}
+	for _, e := range entries {
+		s, ok := seats[e.Player]
+		if !ok && e.Kind != matchlog.KindResult {
+			s = &seat{deck: "neutral", drawn: map[int]bool{}, played: map[int][]int{}, onCurve: map[int]bool{}}
+			seats[e.Player] = s
+			order = append(order, e.Player)
+		}
+		switch e.Kind {
+		case matchlog.KindDeal:
+			if e.Class != "" {
+				s.deck = e.Class
+			}
+			if e.Deck != "" {
+				s.deck += " " + e.Deck
+			}
+			for _, c := range e.Cards {
+				s.drawn[c] = true
+			}
+		case matchlog.KindTurn:
+			s.turns++
+			s.before, s.mana = s.mana, e.Mana
+		case matchlog.KindDraw:
+			s.drawn[*e.Card] = true
+		case matchlog.KindPlay:
+			s.drawn[*e.Card] = true
+			s.played[*e.Card] = append(s.played[*e.Card], s.turns)
+			if e.Cost > s.before && e.Cost <= s.mana {
+				s.onCurve[*e.Card] = true
+			}
+		case matchlog.KindResult:
+			report.Matches++
+			won := map[string]bool{}
+			for _, id := range e.Winners {
+				won[id] = true
+			}
+			for _, id := range order {
+				report.add(cards, seats[id], won[id] && !e.Draw)
+			}
+			report.matchup(seats, order, won, e.Draw)
+			seats = map[string]*seat{}
+			order = []string{}
+		}
+	}
+	for _, c := range cards {
+		report.Cards = append(report.Cards, *c)
+	}
+	sort.Slice(report.Cards, func(i, j int) bool { return report.Cards[i].Card < report.Cards[j].Card })
+	return report
+}
+
+func (r *Report) add(cards map[int]*Card, s *seat, won bool) {
+	for card := range s.drawn {
+		c, ok := cards[card]
+		if !ok {
+			c = &Card{Card: card}
+			cards[card] = c
+		}
+		c.Drawn++
+		if won {
+			c.Wins++
+		}
+		if turns := s.played[card]; len(turns) > 0 {
+			c.Played++
+			for _, t := range turns {
+				c.plays++
+				c.turns += t
+			}
+		}
+		if s.onCurve[card] {
+			c.OnCurve++
+			if won {
+				c.OnCurveWins++
+			}
+		}
+	}
+}
+
+// matchup records every winner against every loser, a draw is a draw between everyone
+func (r *Report) matchup(seats map[string]*seat, order []string, won map[string]bool, draw bool) {
+	for _, a := range order {
+		for _, b := range order {
+			if a == b || (!draw && won[a] == won[b]) {
+				continue
+			}
+			da, db := seats[a].deck, seats[b].deck
+			if r.Matchups[da] == nil {
+				r.Matchups[da] = map[string]Record{}
+			}
+			record := r.Matchups[da][db]
+			switch {
+			case draw:
+				record.Draws++
+			case won[a]:
+				record.Wins++
+			default:
+				record.Losses++
+			}
+			r.Matchups[da][db] = record
+		}
+	}
+}
+
+func rate(n, of int) float64 {
+	if of == 0 {
+		return 0
+	}
+	return float64(n) / float64(of)
+}
@@ -0,0 +1,83 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/matchlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func card(c int) *int {
+	return &c
+}
+
+func match(winner string, draw bool) []matchlog.Entry {
+	return []matchlog.Entry{
+		{Turn: 1, Player: "p1", Kind: matchlog.KindDeal, Cards: []int{1, 4}, Class: "mage"},
+		{Turn: 1, Player: "p1", Kind: matchlog.KindTurn, Mana: 1},
+		{Turn: 1, Player: "p1", Kind: matchlog.KindDraw, Card: card(2)},
+		{Turn: 1, Player: "p1", Kind: matchlog.KindPlay, Card: card(1), Cost: 1, Damage: 1},
+		{Turn: 2, Player: "p2", Kind: matchlog.KindDeal, Cards: []int{2}},
+		{Turn: 2, Player: "p2", Kind: matchlog.KindTurn, Mana: 2},
+		{Turn: 2, Player: "p2", Kind: matchlog.KindPlay, Card: card(2), Cost: 2, Damage: 2},
+		{Turn: 3, Player: "p1", Kind: matchlog.KindTurn, Mana: 3},
+		{Turn: 3, Player: "p1", Kind: matchlog.KindPlay, Card: card(2), Cost: 2, Damage: 2},
+		{Turn: 3, Player: "p1", Kind: matchlog.KindPlay, Card: card(3), Cost: 3, Damage: 3},
+		{Turn: 3, Player: "p1", Kind: matchlog.KindResult, Winners: []string{winner}, Draw: draw, Reason: game.ReasonKilled},
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	entries := append(match("p1", false), match("p2", false)...)
+	entries = append(entries, match("p1", false)[:3]...)
+
+	r := Aggregate(entries)
+
+	assert.Equal(t, 2, r.Matches, "the unfinished match is left out")
+	assert.Equal(t, []Card{
+		{Card: 1, Drawn: 2, Played: 2, Wins: 1, OnCurve: 2, OnCurveWins: 1, plays: 2, turns: 2},
+		{Card: 2, Drawn: 4, Played: 4, Wins: 2, OnCurve: 4, OnCurveWins: 2, plays: 4, turns: 6},
+		{Card: 3, Drawn: 2, Played: 2, Wins: 1, OnCurve: 2, OnCurveWins: 1, plays: 2, turns: 4},
+		{Card: 4, Drawn: 2, Wins: 1},
+	}, r.Cards)
+	assert.Equal(t, map[string]map[string]Record{
+		"mage":    {"neutral": {Wins: 1, Losses: 1}},
+		"neutral": {"mage": {Wins: 1, Losses: 1}},
+	}, r.Matchups)
+	assert.Equal(t, []string{"mage", "neutral"}, r.Decks())
+}
+
+func TestAggregate_Draw(t *testing.T) {
+	r := Aggregate(match("p1", true))
+	assert.Equal(t, Record{Draws: 1}, r.Matchups["mage"]["neutral"])
+	assert.Equal(t, 0, r.Cards[0].Wins, "a draw isn't a win")
+}
+
+func TestAggregate_DeckLists(t *testing.T) {
+	entries := []matchlog.Entry{}
+	for i, list := range []string{"1a2b3c4d", "5e6f7a8b"} {
+		m := match("p1", false)
+		m[0].Deck = list
+		m[4].Deck = "99999999"
+		if i == 1 {
+			m[4].Class = "mage"
+		}
+		entries = append(entries, m...)
+	}
+
+	r := Aggregate(entries)
+
+	assert.Equal(t, []string{"mage 1a2b3c4d", "mage 5e6f7a8b", "mage 99999999", "neutral 99999999"}, r.Decks(),
+		"decks of one class are told apart by their list")
+	assert.Equal(t, Record{Wins: 1}, r.Matchups["mage 5e6f7a8b"]["mage 99999999"])
+}
+
+func TestCard_Rates(t *testing.T) {
+	c := Card{Drawn: 4, Played: 3, Wins: 2, OnCurve: 2, OnCurveWins: 2, plays: 4, turns: 10}
+	assert.Equal(t, 0.75, c.PlayRate())
+	assert.Equal(t, 0.5, c.WinRateDrawn())
+	assert.Equal(t, 1.0, c.WinRateOnCurve())
+	assert.Equal(t, 2.5, c.AverageTurn())
+	assert.Equal(t, 0.0, Card{}.PlayRate(), "no draws is no rate rather than a division by zero")
+}
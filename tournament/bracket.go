@@ -0,0 +1,64 @@
+package tournament
+
+// pairBracket re-seeds who's left, gives byes to the top seeds until a power of two remain and then
+// pairs the highest seed with the lowest
+func pairBracket(seeded []string) (pairs [][2]string, byes []string) {
+	size := 1
+	for size < len(seeded) {
+		size *= 2
+	}
+	byes = seeded[:size-len(seeded)]
+	rest := seeded[size-len(seeded):]
+	for i := 0; i < len(rest)/2; i++ {
+		pairs = append(pairs, [2]string{rest[i], rest[len(rest)-1-i]})
+	}
+	return pairs, byes
+}
+
+// losses counts the matches each entrant has lost, byes don't count
+func losses(matches []*Match) map[string]int {
+	lost := map[string]int{}
+	for _, m := range matches {
+		if !m.Bye() {
+			lost[m.Loser()]++
+		}
+	}
+	return lost
+}
+
+// pairElimination pairs everyone with fewer than lives losses, within the group with the same number
+// of losses. The last two from different groups meet in the final, so with two lives a final lost by
+// the unbeaten entrant is played again.
+func pairElimination(entrants []Entrant, matches []*Match, lives int) (pairs [][2]string, byes []string, champion string) {
+	lost := losses(matches)
+	groups := make([][]string, lives)
+	alive := []string{}
+	for _, e := range entrants {
+		if lost[e.Name] < lives {
+			groups[lost[e.Name]] = append(groups[lost[e.Name]], e.Name)
+			alive = append(alive, e.Name)
+		}
+	}
+	if len(alive) == 1 {
+		return nil, nil, alive[0]
+	}
+	for _, g := range groups {
+		if len(g) == 1 && len(alive) == 2 {
+			return [][2]string{{alive[0], alive[1]}}, nil, ""
+		}
+	}
+	for _, g := range groups {
+		if len(g) == 0 {
+			continue
+		}
+		if len(g) == 1 {
+			// waits for the other group to whittle down
+			byes = append(byes, g[0])
+			continue
+		}
+		p, b := pairBracket(g)
+		pairs = append(pairs, p...)
+		byes = append(byes, b...)
+	}
+	return pairs, byes, ""
+}
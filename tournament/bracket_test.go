@@ -0,0 +1,114 @@
+package tournament
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_pairBracket(t *testing.T) {
+	tests := []struct {
+		name      string
+		seeded    []string
+		wantPairs [][2]string
+		wantByes  []string
+	}{
+		{
+			name:      "power of two",
+			seeded:    []string{"a", "b", "c", "d"},
+			wantPairs: [][2]string{{"a", "d"}, {"b", "c"}},
+			wantByes:  []string{},
+		},
+		{
+			name:      "top seeds get byes",
+			seeded:    []string{"a", "b", "c", "d", "e"},
+			wantPairs: [][2]string{{"d", "e"}},
+			wantByes:  []string{"a", "b", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs, byes := pairBracket(tt.seeded)
+			assert.Equal(t, tt.wantPairs, pairs)
+			assert.Equal(t, tt.wantByes, byes)
+		})
+	}
+}
+
+func Test_pairElimination(t *testing.T) {
+	four := entrants("a", "b", "c", "d")
+	tests := []struct {
+		name         string
+		lives        int
+		matches      []*Match
+		wantPairs    [][2]string
+		wantByes     []string
+		wantChampion string
+	}{
+		{
+			name:      "single elimination first round",
+			lives:     1,
+			wantPairs: [][2]string{{"a", "d"}, {"b", "c"}},
+		},
+		{
+			name:  "single elimination final",
+			lives: 1,
+			matches: []*Match{
+				{A: "a", B: "d", Winner: "a"},
+				{A: "b", B: "c", Winner: "c"},
+			},
+			wantPairs: [][2]string{{"a", "c"}},
+		},
+		{
+			name:  "single elimination champion",
+			lives: 1,
+			matches: []*Match{
+				{A: "a", B: "d", Winner: "a"},
+				{A: "b", B: "c", Winner: "c"},
+				{A: "a", B: "c", Winner: "c"},
+			},
+			wantChampion: "c",
+		},
+		{
+			name:  "double elimination splits by losses",
+			lives: 2,
+			matches: []*Match{
+				{A: "a", B: "d", Winner: "a"},
+				{A: "b", B: "c", Winner: "c"},
+			},
+			wantPairs: [][2]string{{"a", "c"}, {"b", "d"}},
+		},
+		{
+			name:  "double elimination grand final reset",
+			lives: 2,
+			matches: []*Match{
+				{A: "a", B: "d", Winner: "a"},
+				{A: "b", B: "c", Winner: "c"},
+				{A: "a", B: "c", Winner: "a"},
+				{A: "b", B: "d", Winner: "b"},
+				{A: "c", B: "b", Winner: "c"},
+				{A: "a", B: "c", Winner: "c"},
+			},
+			wantPairs: [][2]string{{"a", "c"}},
+		},
+		{
+			name:  "lone unbeaten entrant waits",
+			lives: 2,
+			matches: []*Match{
+				{A: "a", B: "d", Winner: "a"},
+				{A: "b", B: "c", Winner: "c"},
+				{A: "a", B: "c", Winner: "a"},
+			},
+			wantPairs: [][2]string{{"c", "d"}},
+			wantByes:  []string{"a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs, byes, champion := pairElimination(four, tt.matches, tt.lives)
+			assert.Equal(t, tt.wantPairs, pairs)
+			assert.Equal(t, tt.wantByes, byes)
+			assert.Equal(t, tt.wantChampion, champion)
+		})
+	}
+}
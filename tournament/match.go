@@ -0,0 +1,114 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+)
+
+// MatchRules decides which decks are still in play as a match goes on
+type MatchRules string
+
+const (
+	// Conquest needs a win with a different deck for every game won
+	Conquest MatchRules = "conquest"
+	// LastHeroStanding keeps a winning deck in and knocks a losing one out
+	LastHeroStanding MatchRules = "lhs"
+)
+
+// maxReplays is how many drawn games in a row a match replays before giving up
+const maxReplays = 5
+
+// PlayGame plays one game between two entrants with the given decks, the first one goes first.
+// The result is nil if the game couldn't be played.
+type PlayGame func(first, second Entrant, firstDeck, secondDeck []int) *game.Result
+
+// Match is a best of series between two entrants, with no B it's a bye
+type Match struct {
+	Round  int    `json:"round"`
+	A      string `json:"a"`
+	B      string `json:"b,omitempty"`
+	WinsA  int    `json:"winsA"`
+	WinsB  int    `json:"winsB"`
+	Winner string `json:"winner"`
+}
+
+func (m *Match) Bye() bool {
+	return m.B == ""
+}
+
+func (m *Match) Loser() string {
+	if m.Winner == m.A {
+		return m.B
+	}
+	return m.A
+}
+
+func (m *Match) String() string {
+	if m.Bye() {
+		return fmt.Sprintf("%s has a bye", m.A)
+	}
+	return fmt.Sprintf("%s %d-%d %s", m.A, m.WinsA, m.WinsB, m.B)
+}
+
+// winsNeeded is how many games take a best of series
+func winsNeeded(bestOf int) int {
+	return bestOf/2 + 1
+}
+
+// PlayMatch plays games until one entrant has won a majority of bestOf, drawn games are replayed.
+// Seats swap every game.
+func PlayMatch(round int, a, b Entrant, bestOf int, rules MatchRules, play PlayGame) (*Match, error) {
+	need := winsNeeded(bestOf)
+	decks := [2][][]int{lineup(a, need), lineup(b, need)}
+	if len(decks[0]) < need || len(decks[1]) < need {
+		return nil, fmt.Errorf("best of %d needs %d decks from %s and %s", bestOf, need, a.Name, b.Name)
+	}
+	m := &Match{Round: round, A: a.Name, B: b.Name}
+	wins := [2]*int{&m.WinsA, &m.WinsB}
+	entrants := [2]Entrant{a, b}
+	replays := 0
+	for game := 0; m.WinsA < need && m.WinsB < need; game++ {
+		first, second := 0, 1
+		if game%2 == 1 {
+			first, second = 1, 0
+		}
+		result := play(entrants[first], entrants[second], decks[first][0], decks[second][0])
+		if result == nil {
+			return nil, fmt.Errorf("%s and %s couldn't play a game", a.Name, b.Name)
+		}
+		if result.Draw {
+			replays++
+			if replays > maxReplays {
+				return nil, errors.New("Too many drawn games in a row")
+			}
+			continue
+		}
+		replays = 0
+		winner, loser := 0, 1
+		if result.Winners[0] == b.Name {
+			winner, loser = 1, 0
+		}
+		*wins[winner]++
+		switch rules {
+		case Conquest:
+			decks[winner] = decks[winner][1:]
+		case LastHeroStanding:
+			decks[loser] = decks[loser][1:]
+		}
+	}
+	m.Winner = a.Name
+	if m.WinsB > m.WinsA {
+		m.Winner = b.Name
+	}
+	return m, nil
+}
+
+// lineup is the decks an entrant brings to a match, only the first need of them play
+func lineup(e Entrant, need int) [][]int {
+	if len(e.Decks) > need {
+		return e.Decks[:need]
+	}
+	return e.Decks
+}
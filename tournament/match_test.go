@@ -0,0 +1,98 @@
+package tournament
+
+import (
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+)
+
+type playedGame struct {
+	first, second         string
+	firstDeck, secondDeck int
+}
+
+// scripted plays games whose winners are given in order, "" is a draw. Decks are told apart by their
+// first card.
+func scripted(winners ...string) (PlayGame, *[]playedGame) {
+	played := &[]playedGame{}
+	return func(first, second Entrant, firstDeck, secondDeck []int) *game.Result {
+		*played = append(*played, playedGame{first.Name, second.Name, firstDeck[0], secondDeck[0]})
+		w := winners[0]
+		winners = winners[1:]
+		switch w {
+		case "":
+			return &game.Result{Winners: []string{first.Name, second.Name}, Losers: []string{}, Draw: true}
+		case first.Name:
+			return &game.Result{Winners: []string{first.Name}, Losers: []string{second.Name}}
+		}
+		return &game.Result{Winners: []string{second.Name}, Losers: []string{first.Name}}
+	}, played
+}
+
+func TestPlayMatch(t *testing.T) {
+	a := Entrant{Name: "a", Decks: [][]int{{1}, {2}, {3}}}
+	b := Entrant{Name: "b", Decks: [][]int{{4}, {5}, {6}}}
+	tests := []struct {
+		name    string
+		bestOf  int
+		rules   MatchRules
+		winners []string
+		want    *Match
+		games   []playedGame
+	}{
+		{
+			name:    "conquest retires the winning deck",
+			bestOf:  3,
+			rules:   Conquest,
+			winners: []string{"a", "b", "a"},
+			want:    &Match{Round: 1, A: "a", B: "b", WinsA: 2, WinsB: 1, Winner: "a"},
+			games:   []playedGame{{"a", "b", 1, 4}, {"b", "a", 4, 2}, {"a", "b", 2, 5}},
+		},
+		{
+			name:    "last hero standing knocks out the losing deck",
+			bestOf:  3,
+			rules:   LastHeroStanding,
+			winners: []string{"a", "a"},
+			want:    &Match{Round: 1, A: "a", B: "b", WinsA: 2, WinsB: 0, Winner: "a"},
+			games:   []playedGame{{"a", "b", 1, 4}, {"b", "a", 5, 1}},
+		},
+		{
+			name:    "draws are replayed",
+			bestOf:  1,
+			rules:   Conquest,
+			winners: []string{"", "b"},
+			want:    &Match{Round: 1, A: "a", B: "b", WinsA: 0, WinsB: 1, Winner: "b"},
+			games:   []playedGame{{"a", "b", 1, 4}, {"b", "a", 4, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			play, played := scripted(tt.winners...)
+			got, err := PlayMatch(1, a, b, tt.bestOf, tt.rules, play)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.games, *played)
+		})
+	}
+}
+
+func TestPlayMatch_errors(t *testing.T) {
+	a := Entrant{Name: "a", Decks: [][]int{{1}}}
+	b := Entrant{Name: "b", Decks: [][]int{{4}}}
+
+	_, err := PlayMatch(1, a, b, 3, Conquest, nil)
+	assert.EqualError(t, err, "best of 3 needs 2 decks from a and b")
+
+	play, _ := scripted("", "", "", "", "", "")
+	_, err = PlayMatch(1, a, b, 1, Conquest, play)
+	assert.EqualError(t, err, "Too many drawn games in a row")
+
+	_, err = PlayMatch(1, a, b, 1, Conquest, func(first, second Entrant, firstDeck, secondDeck []int) *game.Result { return nil })
+	assert.EqualError(t, err, "a and b couldn't play a game")
+}
+
+func TestMatch_String(t *testing.T) {
+	assert.Equal(t, "a 2-1 b", (&Match{A: "a", B: "b", WinsA: 2, WinsB: 1}).String())
+	assert.Equal(t, "a has a bye", (&Match{A: "a", Winner: "a"}).String())
+}
@@ -0,0 +1,45 @@
+package tournament
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// Entrant is a player and their lineup of decks, in the order they bring them
+type Entrant struct {
+	Name  string  `json:"name"`
+	Decks [][]int `json:"decks"`
+	// AI entrants play themselves
+	AI bool `json:"ai"`
+}
+
+// LoadRoster reads the entrants from a JSON data file, in seed order
+func LoadRoster(path string) ([]Entrant, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file := struct {
+		Entrants []Entrant `json:"entrants"`
+	}{}
+	if err := json.Unmarshal(b, &file); err != nil {
+		return nil, fmt.Errorf("reading roster %s: %v", path, err)
+	}
+	seen := map[string]bool{}
+	for i, e := range file.Entrants {
+		switch {
+		case e.Name == "":
+			return nil, fmt.Errorf("roster %s: entrant %d has no name", path, i+1)
+		case seen[e.Name]:
+			return nil, fmt.Errorf("roster %s: %q is entered twice", path, e.Name)
+		}
+		for _, d := range e.Decks {
+			if len(d) < 3 {
+				return nil, fmt.Errorf("roster %s: %s has a deck with fewer than 3 cards", path, e.Name)
+			}
+		}
+		seen[e.Name] = true
+	}
+	return file.Entrants, nil
+}
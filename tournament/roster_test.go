@@ -0,0 +1,74 @@
+package tournament
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadRoster_Shipped(t *testing.T) {
+	entrants, err := LoadRoster(filepath.Join("..", "data", "roster.json"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, entrants)
+}
+
+func TestLoadRoster(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []Entrant
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `{"entrants": [{"name": "a", "decks": [[1, 2, 3]], "ai": true}]}`,
+			want: []Entrant{{Name: "a", Decks: [][]int{{1, 2, 3}}, AI: true}},
+		},
+		{
+			name:    "not json",
+			data:    `{`,
+			wantErr: "reading roster",
+		},
+		{
+			name:    "no name",
+			data:    `{"entrants": [{"decks": []}]}`,
+			wantErr: "entrant 1 has no name",
+		},
+		{
+			name:    "entered twice",
+			data:    `{"entrants": [{"name": "a"}, {"name": "a"}]}`,
+			wantErr: `"a" is entered twice`,
+		},
+		{
+			name:    "small deck",
+			data:    `{"entrants": [{"name": "a", "decks": [[1]]}]}`,
+			wantErr: "a has a deck with fewer than 3 cards",
+		},
+	}
+	dir, err := ioutil.TempDir("", "roster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "roster.json")
+			if err := ioutil.WriteFile(path, []byte(tt.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := LoadRoster(path)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadRoster() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package tournament
+
+import (
+	"sort"
+)
+
+// Standing is an entrant's record in a Swiss tournament
+type Standing struct {
+	Name        string
+	Points      int
+	MatchWins   int
+	MatchLosses int
+	GameWins    int
+	GameLosses  int
+	// OpponentMatchWin is the average match win rate of everyone they've played, the first tiebreaker
+	OpponentMatchWin float64
+	// GameWin is their own game win rate, the second tiebreaker
+	GameWin   float64
+	seed      int
+	hadBye    bool
+	opponents []string
+}
+
+const (
+	pointsPerWin = 3
+	// minWinRate stops early losses dragging tiebreakers down too far
+	minWinRate = 1.0 / 3
+)
+
+// standings ranks entrants by points then the tiebreakers then seed
+func standings(entrants []Entrant, matches []*Match) []*Standing {
+	byName := map[string]*Standing{}
+	all := make([]*Standing, len(entrants))
+	for i, e := range entrants {
+		all[i] = &Standing{Name: e.Name, seed: i}
+		byName[e.Name] = all[i]
+	}
+	for _, m := range matches {
+		a := byName[m.A]
+		if m.Bye() {
+			a.hadBye = true
+			a.MatchWins++
+			a.Points += pointsPerWin
+			continue
+		}
+		b := byName[m.B]
+		a.opponents = append(a.opponents, b.Name)
+		b.opponents = append(b.opponents, a.Name)
+		a.GameWins += m.WinsA
+		a.GameLosses += m.WinsB
+		b.GameWins += m.WinsB
+		b.GameLosses += m.WinsA
+		winner, loser := byName[m.Winner], byName[m.Loser()]
+		winner.MatchWins++
+		winner.Points += pointsPerWin
+		loser.MatchLosses++
+	}
+	for _, s := range all {
+		s.GameWin = rate(s.GameWins, s.GameLosses)
+		if len(s.opponents) == 0 {
+			continue
+		}
+		total := 0.0
+		for _, o := range s.opponents {
+			total += rate(byName[o].MatchWins, byName[o].MatchLosses)
+		}
+		s.OpponentMatchWin = total / float64(len(s.opponents))
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		a, b := all[i], all[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.OpponentMatchWin != b.OpponentMatchWin {
+			return a.OpponentMatchWin > b.OpponentMatchWin
+		}
+		if a.GameWin != b.GameWin {
+			return a.GameWin > b.GameWin
+		}
+		return a.seed < b.seed
+	})
+	return all
+}
+
+func rate(wins, losses int) float64 {
+	if wins+losses == 0 {
+		return minWinRate
+	}
+	r := float64(wins) / float64(wins+losses)
+	if r < minWinRate {
+		return minWinRate
+	}
+	return r
+}
+
+// pairSwiss pairs entrants in standings order avoiding rematches where it can, with an odd number
+// the lowest ranked entrant who hasn't had a bye gets one
+func pairSwiss(ranked []*Standing) (pairs [][2]string, bye string) {
+	players := append([]*Standing{}, ranked...)
+	if len(players)%2 == 1 {
+		at := len(players) - 1
+		for i := len(players) - 1; i >= 0; i-- {
+			if !players[i].hadBye {
+				at = i
+				break
+			}
+		}
+		bye = players[at].Name
+		players = append(players[:at], players[at+1:]...)
+	}
+	if pairs, ok := pairFresh(players); ok {
+		return pairs, bye
+	}
+	// everyone has played everyone they could, rematches it is
+	for i := 0; i+1 < len(players); i += 2 {
+		pairs = append(pairs, [2]string{players[i].Name, players[i+1].Name})
+	}
+	return pairs, bye
+}
+
+// pairFresh pairs the top player with the highest ranked opponent they haven't played, backtracking
+// when that leaves the rest unpairable
+func pairFresh(players []*Standing) ([][2]string, bool) {
+	if len(players) == 0 {
+		return [][2]string{}, true
+	}
+	top := players[0]
+	for i := 1; i < len(players); i++ {
+		if contains(top.opponents, players[i].Name) {
+			continue
+		}
+		rest := append(append([]*Standing{}, players[1:i]...), players[i+1:]...)
+		if pairs, ok := pairFresh(rest); ok {
+			return append([][2]string{{top.Name, players[i].Name}}, pairs...), true
+		}
+	}
+	return nil, false
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
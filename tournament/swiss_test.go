@@ -0,0 +1,101 @@
+package tournament
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func entrants(names ...string) []Entrant {
+	all := make([]Entrant, len(names))
+	for i, n := range names {
+		all[i] = Entrant{Name: n, Decks: [][]int{{1, 1, 1}}}
+	}
+	return all
+}
+
+func names(ranked []*Standing) []string {
+	n := make([]string, len(ranked))
+	for i, s := range ranked {
+		n[i] = s.Name
+	}
+	return n
+}
+
+func Test_standings(t *testing.T) {
+	matches := []*Match{
+		{Round: 1, A: "a", B: "b", WinsA: 2, WinsB: 1, Winner: "a"},
+		{Round: 1, A: "c", B: "d", WinsA: 2, WinsB: 0, Winner: "c"},
+		{Round: 2, A: "a", B: "c", WinsA: 0, WinsB: 2, Winner: "c"},
+		{Round: 2, A: "b", B: "d", WinsA: 2, WinsB: 0, Winner: "b"},
+	}
+	got := standings(entrants("a", "b", "c", "d"), matches)
+
+	assert.Equal(t, []string{"c", "a", "b", "d"}, names(got))
+	assert.Equal(t, 6, got[0].Points)
+	assert.Equal(t, 4, got[0].GameWins)
+	// a and b are both 1-1, a played c (1.0) and b (0.5), b played a (0.5) and d (floored to 1/3)
+	assert.InDelta(t, 0.75, got[1].OpponentMatchWin, 1e-9)
+	assert.InDelta(t, (0.5+1.0/3)/2, got[2].OpponentMatchWin, 1e-9)
+	assert.InDelta(t, 0.4, got[1].GameWin, 1e-9)
+}
+
+func Test_standingsBye(t *testing.T) {
+	got := standings(entrants("a", "b"), []*Match{{Round: 1, A: "b", Winner: "b"}})
+	assert.Equal(t, []string{"b", "a"}, names(got))
+	assert.True(t, got[0].hadBye)
+	assert.Equal(t, pointsPerWin, got[0].Points)
+}
+
+func Test_pairSwiss(t *testing.T) {
+	tests := []struct {
+		name      string
+		entrants  []Entrant
+		matches   []*Match
+		wantPairs [][2]string
+		wantBye   string
+	}{
+		{
+			name:      "first round in seed order",
+			entrants:  entrants("a", "b", "c", "d"),
+			wantPairs: [][2]string{{"a", "b"}, {"c", "d"}},
+		},
+		{
+			name:     "avoids rematches",
+			entrants: entrants("a", "b", "c", "d"),
+			matches: []*Match{
+				{Round: 1, A: "a", B: "b", WinsA: 1, Winner: "a"},
+				{Round: 1, A: "c", B: "d", WinsA: 1, Winner: "c"},
+			},
+			wantPairs: [][2]string{{"a", "c"}, {"b", "d"}},
+		},
+		{
+			name:     "backtracks when the greedy pairing strands a rematch",
+			entrants: entrants("a", "b", "c", "d"),
+			matches: []*Match{
+				{Round: 1, A: "a", B: "b", WinsA: 1, Winner: "a"},
+				{Round: 1, A: "c", B: "d", WinsA: 1, Winner: "c"},
+				{Round: 2, A: "a", B: "c", WinsA: 1, Winner: "a"},
+				{Round: 2, A: "b", B: "d", WinsA: 1, Winner: "b"},
+			},
+			wantPairs: [][2]string{{"a", "d"}, {"b", "c"}},
+		},
+		{
+			name:     "bye goes to the lowest ranked without one",
+			entrants: entrants("a", "b", "c"),
+			matches: []*Match{
+				{Round: 1, A: "a", B: "b", WinsA: 1, Winner: "a"},
+				{Round: 1, A: "c", Winner: "c"},
+			},
+			wantPairs: [][2]string{{"a", "c"}},
+			wantBye:   "b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs, bye := pairSwiss(standings(tt.entrants, tt.matches))
+			assert.Equal(t, tt.wantPairs, pairs)
+			assert.Equal(t, tt.wantBye, bye)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+type Format string
+
+const (
+	Swiss             Format = "swiss"
+	SingleElimination Format = "single"
+	DoubleElimination Format = "double"
+)
+
+// Tournament runs rounds of matches between the entrants and records every one of them
+type Tournament struct {
+	Format   Format     `json:"format"`
+	BestOf   int        `json:"bestOf"`
+	Rules    MatchRules `json:"rules"`
+	Matches  []*Match   `json:"matches"`
+	Champion string     `json:"champion"`
+	entrants []Entrant
+	// rounds is how many Swiss rounds are played
+	rounds int
+}
+
+// NewTournament checks everyone can play the format, with rounds of 0 Swiss plays enough rounds to
+// leave one unbeaten entrant
+func NewTournament(entrants []Entrant, format Format, bestOf int, rules MatchRules, rounds int) (*Tournament, error) {
+	if format != Swiss && format != SingleElimination && format != DoubleElimination {
+		return nil, fmt.Errorf("unknown format %q, use swiss, single or double", format)
+	}
+	if rules != Conquest && rules != LastHeroStanding {
+		return nil, fmt.Errorf("unknown match rules %q, use conquest or lhs", rules)
+	}
+	if bestOf < 1 || bestOf%2 == 0 {
+		return nil, fmt.Errorf("matches are best of an odd number, got %d", bestOf)
+	}
+	if len(entrants) < 2 {
+		return nil, errors.New("A tournament needs at least 2 entrants")
+	}
+	for _, e := range entrants {
+		if len(e.Decks) < winsNeeded(bestOf) {
+			return nil, fmt.Errorf("%s needs %d decks for best of %d", e.Name, winsNeeded(bestOf), bestOf)
+		}
+	}
+	if rounds <= 0 {
+		for n := 1; n < len(entrants); n *= 2 {
+			rounds++
+		}
+	}
+	return &Tournament{
+		Format:   format,
+		BestOf:   bestOf,
+		Rules:    rules,
+		Matches:  []*Match{},
+		entrants: entrants,
+		rounds:   rounds,
+	}, nil
+}
+
+// Run plays round after round until there's a champion, printing each match as it finishes
+func (t *Tournament) Run(play PlayGame, out io.Writer) error {
+	byName := map[string]Entrant{}
+	for _, e := range t.entrants {
+		byName[e.Name] = e
+	}
+	for round := 1; t.Champion == ""; round++ {
+		pairs, byes := t.pair(round)
+		if t.Champion != "" {
+			break
+		}
+		fmt.Fprintf(out, "Round %d\n", round)
+		for _, b := range byes {
+			t.record(out, &Match{Round: round, A: b, Winner: b})
+		}
+		for _, p := range pairs {
+			m, err := PlayMatch(round, byName[p[0]], byName[p[1]], t.BestOf, t.Rules, play)
+			if err != nil {
+				return err
+			}
+			t.record(out, m)
+		}
+	}
+	fmt.Fprintf(out, "%s wins the tournament!\n", t.Champion)
+	return nil
+}
+
+func (t *Tournament) record(out io.Writer, m *Match) {
+	t.Matches = append(t.Matches, m)
+	fmt.Fprintf(out, "  %s\n", m)
+}
+
+// pair sets the champion instead once the tournament is over
+func (t *Tournament) pair(round int) (pairs [][2]string, byes []string) {
+	switch t.Format {
+	case Swiss:
+		if round > t.rounds {
+			t.Champion = t.Standings()[0].Name
+			return nil, nil
+		}
+		pairs, bye := pairSwiss(standings(t.entrants, t.Matches))
+		if bye != "" {
+			byes = []string{bye}
+		}
+		return pairs, byes
+	case DoubleElimination:
+		pairs, byes, t.Champion = pairElimination(t.entrants, t.Matches, 2)
+	default:
+		pairs, byes, t.Champion = pairElimination(t.entrants, t.Matches, 1)
+	}
+	return pairs, byes
+}
+
+// Standings ranks the entrants on the matches played so far
+func (t *Tournament) Standings() []*Standing {
+	return standings(t.entrants, t.Matches)
+}
@@ -0,0 +1,98 @@
+package tournament
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+)
+
+// alphabetical has the name that sorts first win every game
+func alphabetical(first, second Entrant, firstDeck, secondDeck []int) *game.Result {
+	if first.Name < second.Name {
+		return &game.Result{Winners: []string{first.Name}, Losers: []string{second.Name}}
+	}
+	return &game.Result{Winners: []string{second.Name}, Losers: []string{first.Name}}
+}
+
+func TestNewTournament(t *testing.T) {
+	tests := []struct {
+		name     string
+		entrants []Entrant
+		format   Format
+		bestOf   int
+		rules    MatchRules
+		rounds   int
+		want     int
+		wantErr  string
+	}{
+		{name: "rounds for swiss", entrants: entrants("a", "b", "c", "d", "e"), format: Swiss, bestOf: 1, rules: Conquest, want: 3},
+		{name: "rounds given", entrants: entrants("a", "b"), format: Swiss, bestOf: 1, rules: Conquest, rounds: 4, want: 4},
+		{name: "unknown format", entrants: entrants("a", "b"), format: "league", bestOf: 1, rules: Conquest, wantErr: `unknown format "league", use swiss, single or double`},
+		{name: "unknown rules", entrants: entrants("a", "b"), format: Swiss, bestOf: 1, rules: "x", wantErr: `unknown match rules "x", use conquest or lhs`},
+		{name: "even best of", entrants: entrants("a", "b"), format: Swiss, bestOf: 2, rules: Conquest, wantErr: "matches are best of an odd number, got 2"},
+		{name: "too few", entrants: entrants("a"), format: Swiss, bestOf: 1, rules: Conquest, wantErr: "A tournament needs at least 2 entrants"},
+		{name: "not enough decks", entrants: entrants("a", "b"), format: Swiss, bestOf: 3, rules: Conquest, wantErr: "a needs 2 decks for best of 3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTournament(tt.entrants, tt.format, tt.bestOf, tt.rules, tt.rounds)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.rounds)
+		})
+	}
+}
+
+func TestTournament_Run(t *testing.T) {
+	tests := []struct {
+		name        string
+		format      Format
+		wantMatches int
+		wantOut     string
+	}{
+		{
+			name:        "single elimination",
+			format:      SingleElimination,
+			wantMatches: 7,
+			wantOut:     "Round 1\n  a has a bye\n  b has a bye\n  c has a bye\n  d 1-0 e\nRound 2\n  a 1-0 d\n  b 1-0 c\nRound 3\n  a 1-0 b\na wins the tournament!\n",
+		},
+		{
+			name:        "swiss",
+			format:      Swiss,
+			wantMatches: 9,
+		},
+		{
+			name:   "double elimination",
+			format: DoubleElimination,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tour, err := NewTournament(entrants("a", "b", "c", "d", "e"), tt.format, 1, Conquest, 0)
+			assert.NoError(t, err)
+			out := &bytes.Buffer{}
+
+			assert.NoError(t, tour.Run(alphabetical, out))
+			assert.Equal(t, "a", tour.Champion)
+			if tt.wantMatches > 0 {
+				assert.Len(t, tour.Matches, tt.wantMatches)
+			}
+			if tt.wantOut != "" {
+				assert.Equal(t, tt.wantOut, out.String())
+			}
+		})
+	}
+}
+
+func TestTournament_RunDoubleEliminationLosses(t *testing.T) {
+	tour, err := NewTournament(entrants("a", "b", "c", "d"), DoubleElimination, 1, Conquest, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, tour.Run(alphabetical, &bytes.Buffer{}))
+	lost := losses(tour.Matches)
+	assert.Equal(t, map[string]int{"b": 2, "c": 2, "d": 2}, lost)
+}
@@ -0,0 +1,376 @@
+package tui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ShookieShookie/WorkshopImpl/command"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+)
+
+const (
+	clearScreen = "\x1b[H\x1b[2J"
+	hideCursor  = "\x1b[?25l"
+	showCursor  = "\x1b[?25h"
+	width       = 78
+	barWidth    = 20
+	maxMana     = 10
+	logLines    = 8
+	logKept     = 200
+)
+
+// hero is what the screen needs beyond game.Player to draw mana and the hand
+type hero interface {
+	GetMana() int
+	ShowHand() []int
+}
+
+// Screen draws the whole game on a terminal and turns keypresses into game input
+type Screen struct {
+	mu        sync.Mutex
+	keys      *bufio.Reader
+	term      io.Writer
+	active    game.Player
+	table     *game.Table
+	maxHealth map[string]int
+	board     []int
+	log       []string
+	partial   string
+	selected  int
+	// prompting is set while a typed command is being entered after ':'
+	prompting bool
+	prompt    string
+	hint      string
+}
+
+func NewScreen(keys io.Reader, term io.Writer) *Screen {
+	return &Screen{
+		keys:      bufio.NewReader(keys),
+		term:      term,
+		maxHealth: map[string]int{},
+		log:       []string{},
+	}
+}
+
+// Write adds game output to the event log
+func (s *Screen) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	text := s.partial + string(p)
+	lines := strings.Split(text, "\n")
+	s.partial = lines[len(lines)-1]
+	for _, l := range lines[:len(lines)-1] {
+		if l = strings.TrimSpace(l); l != "" {
+			s.log = append(s.log, l)
+		}
+	}
+	if len(s.log) > logKept {
+		s.log = s.log[len(s.log)-logKept:]
+	}
+	s.redraw()
+	return len(p), nil
+}
+
+// Wrap lets the screen follow whose turn it is and what gets played
+func (s *Screen) Wrap(turn game.TurnFunc) game.TurnFunc {
+	return func(iter int, active game.Player, table *game.Table, getInput func() string) *game.Result {
+		s.mu.Lock()
+		s.active = active
+		s.table = table
+		s.board = nil
+		s.selected = 0
+		for _, p := range table.Players() {
+			s.trackHealth(p)
+		}
+		s.mu.Unlock()
+		return turn(iter, &shownPlayer{Player: active, screen: s}, table, getInput)
+	}
+}
+
+// Input waits for a key that means something to the game, ':' opens a prompt for typed commands
+func (s *Screen) Input() string {
+	s.mu.Lock()
+	s.redraw()
+	s.mu.Unlock()
+	for {
+		b, err := s.keys.ReadByte()
+		if err != nil {
+			return game.Disconnected
+		}
+		switch {
+		case b == 0x1b:
+			s.escape()
+		case b == 'h' || b == 'a':
+			s.move(-1)
+		case b == 'l':
+			s.move(1)
+		case b == '\r' || b == '\n':
+			s.mu.Lock()
+			selected := s.selected
+			s.mu.Unlock()
+			return strconv.Itoa(selected)
+		case b >= '0' && b <= '9':
+			return string(b)
+		case b == 'e' || b == ' ':
+			return string(command.End)
+		case b == 'p':
+			return string(command.Power)
+		case b == 'c':
+			return string(command.Concede)
+		case b == 'd':
+			return string(command.Draw)
+		case b == 'y':
+			return string(command.Accept)
+		case b == 'n':
+			return string(command.Decline)
+		case b == ':':
+			if line, ok := s.typeCommand(); ok {
+				return line
+			}
+		case b == 4: // ctrl-d
+			return game.Disconnected
+		}
+	}
+}
+
+// typeCommand reads a command line with tab completion, it's cancelled by escape
+func (s *Screen) typeCommand() (string, bool) {
+	s.setPrompt(true, "", "")
+	defer s.setPrompt(false, "", "")
+	line := ""
+	for {
+		b, err := s.keys.ReadByte()
+		if err != nil {
+			return game.Disconnected, true
+		}
+		hint := ""
+		switch {
+		case b == '\r' || b == '\n':
+			return line, true
+		case b == 0x1b:
+			return "", false
+		case b == 127 || b == 8:
+			if len(line) > 0 {
+				line = line[:len(line)-1]
+			}
+		case b == '\t':
+			options := command.Complete(line)
+			switch len(options) {
+			case 0:
+				hint = "no completions"
+			case 1:
+				line = options[0]
+			default:
+				line = commonPrefix(options)
+				hint = strings.Join(options, "  ")
+			}
+		case b >= ' ' && b < 127:
+			line += string(b)
+		}
+		s.setPrompt(true, line, hint)
+	}
+}
+
+func (s *Screen) setPrompt(prompting bool, line, hint string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prompting = prompting
+	s.prompt = line
+	s.hint = hint
+	s.redraw()
+}
+
+func commonPrefix(options []string) string {
+	prefix := options[0]
+	for _, o := range options[1:] {
+		for !strings.HasPrefix(o, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+// escape handles the arrow key sequences ESC [ A-D
+func (s *Screen) escape() {
+	if b, err := s.keys.ReadByte(); err != nil || b != '[' {
+		return
+	}
+	b, err := s.keys.ReadByte()
+	if err != nil {
+		return
+	}
+	switch b {
+	case 'C', 'A':
+		s.move(1)
+	case 'D', 'B':
+		s.move(-1)
+	}
+}
+
+func (s *Screen) move(delta int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.selected += delta
+	s.clampSelection()
+	s.redraw()
+}
+
+func (s *Screen) clampSelection() {
+	size := len(s.hand(s.active))
+	if s.selected >= size {
+		s.selected = size - 1
+	}
+	if s.selected < 0 {
+		s.selected = 0
+	}
+}
+
+func (s *Screen) hand(p game.Player) []int {
+	if h, ok := p.(hero); ok {
+		return h.ShowHand()
+	}
+	return nil
+}
+
+func (s *Screen) redraw() {
+	io.WriteString(s.term, hideCursor+clearScreen+s.render())
+}
+
+// render draws everyone else on top, the board in the middle and the active player with their hand below
+func (s *Screen) render() string {
+	b := &strings.Builder{}
+	if s.active == nil {
+		b.WriteString(rule("log"))
+		s.renderLog(b)
+		return b.String()
+	}
+	s.clampSelection()
+	for _, p := range s.table.Players() {
+		if p.ID() != s.active.ID() {
+			s.renderHero(b, p, false)
+		}
+	}
+	b.WriteString(rule("board"))
+	played := make([]string, len(s.board))
+	for i, c := range s.board {
+		played[i] = strconv.Itoa(c)
+	}
+	fmt.Fprintf(b, " played this turn: %s\n", strings.Join(played, " "))
+	b.WriteString(rule(""))
+	s.renderHero(b, s.active, true)
+	s.renderHand(b)
+	b.WriteString(rule("log"))
+	s.renderLog(b)
+	b.WriteString(rule(""))
+	if s.prompting {
+		fmt.Fprintf(b, " :%s_\n", s.prompt)
+		if s.hint != "" {
+			fmt.Fprintf(b, " %s\n", s.hint)
+		}
+		return b.String()
+	}
+	b.WriteString(" ←/→ select  enter play  p hero power  e end turn  c concede  d offer draw  y/n answer  : command\n")
+	return b.String()
+}
+
+func (s *Screen) renderHero(b *strings.Builder, p game.Player, active bool) {
+	health := s.trackHealth(p)
+	name := p.ID()
+	switch {
+	case active:
+		name += "  (your turn)"
+	case s.table.IsOut(p):
+		name += "  (out)"
+	}
+	fmt.Fprintf(b, " %s\n", name)
+	fmt.Fprintf(b, " HP %s %d/%d\n", bar(health, s.maxHealth[p.ID()]), health, s.maxHealth[p.ID()])
+	if h, ok := p.(hero); ok {
+		fmt.Fprintf(b, " MP %s %d/%d\n", bar(h.GetMana(), maxMana), h.GetMana(), maxMana)
+	}
+}
+
+// trackHealth remembers the most health p has been seen with to size their bar
+func (s *Screen) trackHealth(p game.Player) int {
+	health := p.GetHealth()
+	if health > s.maxHealth[p.ID()] {
+		s.maxHealth[p.ID()] = health
+	}
+	return health
+}
+
+func (s *Screen) renderHand(b *strings.Builder) {
+	cards := s.hand(s.active)
+	top, mid, bottom, marker := "", "", "", ""
+	for i, c := range cards {
+		top += " ┌───┐"
+		mid += fmt.Sprintf(" │%2d │", c)
+		bottom += " └───┘"
+		if i == s.selected {
+			marker += "   ^  "
+		} else {
+			marker += "      "
+		}
+	}
+	fmt.Fprintf(b, "%s\n%s\n%s\n%s\n", top, mid, bottom, marker)
+}
+
+func (s *Screen) renderLog(b *strings.Builder) {
+	start := len(s.log) - logLines
+	if start < 0 {
+		start = 0
+	}
+	for _, l := range s.log[start:] {
+		fmt.Fprintf(b, " %s\n", l)
+	}
+	for i := len(s.log) - start; i < logLines; i++ {
+		b.WriteString("\n")
+	}
+}
+
+func bar(value, max int) string {
+	filled := 0
+	if max > 0 && value > 0 {
+		filled = value * barWidth / max
+	}
+	if filled > barWidth {
+		filled = barWidth
+	}
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", barWidth-filled) + "]"
+}
+
+func rule(title string) string {
+	if title == "" {
+		return strings.Repeat("─", width) + "\n"
+	}
+	return "── " + title + " " + strings.Repeat("─", width-len(title)-4) + "\n"
+}
+
+// shownPlayer keeps PrintStats off the screen and puts played cards on the board
+type shownPlayer struct {
+	game.Player
+	screen *Screen
+}
+
+func (p *shownPlayer) Unwrap() game.Player {
+	return p.Player
+}
+
+func (p *shownPlayer) PrintStats() {
+	p.screen.mu.Lock()
+	defer p.screen.mu.Unlock()
+	p.screen.redraw()
+}
+
+func (p *shownPlayer) PlayCard(index int) (int, error) {
+	damage, err := p.Player.PlayCard(index)
+	if err == nil {
+		p.screen.mu.Lock()
+		p.screen.board = append(p.screen.board, damage)
+		p.screen.mu.Unlock()
+	}
+	return damage, err
+}
@@ -0,0 +1,129 @@
+package tui
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeHero struct {
+	id     string
+	health int
+	mana   int
+	hand   []int
+}
+
+func (f *fakeHero) ApplyDamage(d int) { f.health -= d }
+func (f *fakeHero) GetHealth() int    { return f.health }
+func (f *fakeHero) SetMana(m int)     { f.mana = m }
+func (f *fakeHero) PlayCard(index int) (int, error) {
+	v := f.hand[index]
+	f.hand = append(f.hand[:index], f.hand[index+1:]...)
+	return v, nil
+}
+func (f *fakeHero) IsDead() bool    { return f.health <= 0 }
+func (f *fakeHero) Draw() error     { return nil }
+func (f *fakeHero) ID() string      { return f.id }
+func (f *fakeHero) PrintStats()     {}
+func (f *fakeHero) GetMana() int    { return f.mana }
+func (f *fakeHero) ShowHand() []int { return f.hand }
+
+func TestScreen_Input(t *testing.T) {
+	tests := []struct {
+		name string
+		keys string
+		want string
+	}{
+		{name: "enter plays the first card", keys: "\n", want: "0"},
+		{name: "right arrow then enter", keys: "\x1b[C\x1b[C\r", want: "2"},
+		{name: "left stops at the first card", keys: "\x1b[D\x1b[Dl\n", want: "1"},
+		{name: "selection stops at the last card", keys: "lllll\n", want: "2"},
+		{name: "number picks directly", keys: "1", want: "1"},
+		{name: "end turn", keys: "e", want: "end"},
+		{name: "unknown keys are ignored", keys: "zq c", want: "end"},
+		{name: "hero power", keys: "p", want: "power"},
+		{name: "concede", keys: "c", want: "concede"},
+		{name: "offer draw", keys: "d", want: "draw"},
+		{name: "accept draw", keys: "y", want: "accept"},
+		{name: "typed command", keys: ":play 2 at face\r", want: "play 2 at face"},
+		{name: "tab completes", keys: ":pl\t1 \t\tf\t\n", want: "play 1 at face"},
+		{name: "backspace", keys: ":endd\x7f\n", want: "end"},
+		{name: "escape cancels the prompt", keys: ":play\x1b2", want: "2"},
+		{name: "closed input", keys: "", want: game.Disconnected},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewScreen(strings.NewReader(tt.keys), ioutil.Discard)
+			s.active = &fakeHero{id: "p1", health: 30, hand: []int{1, 2, 3}}
+			s.table = game.NewTable([]game.Player{s.active, &fakeHero{id: "p2", health: 30}}, nil)
+			if got := s.Input(); got != tt.want {
+				t.Errorf("Screen.Input() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScreen_Write(t *testing.T) {
+	s := NewScreen(strings.NewReader(""), ioutil.Discard)
+	s.Write([]byte("GAME START\nplayer1's "))
+	s.Write([]byte("turn!\n\n"))
+	assert.Equal(t, []string{"GAME START", "player1's turn!"}, s.log)
+}
+
+func TestScreen_Wrap(t *testing.T) {
+	term := &bytes.Buffer{}
+	s := NewScreen(strings.NewReader(""), term)
+	p1 := &fakeHero{id: "player1", health: 30, mana: 4, hand: []int{3, 5}}
+	p2 := &fakeHero{id: "player2", health: 30}
+	p3 := &fakeHero{id: "player3", health: 0}
+	table := game.NewTable([]game.Player{p1, p2, p3}, nil)
+	table.Eliminate(p3)
+	turn := func(iter int, active game.Player, table *game.Table, getInput func() string) *game.Result {
+		assert.Equal(t, p1, active.(game.Wrapper).Unwrap())
+		damage, _ := active.PlayCard(0)
+		table.Opponents(active)[0].ApplyDamage(damage)
+		active.PrintStats()
+		return nil
+	}
+	s.Wrap(turn)(1, p1, table, nil)
+
+	frame := s.render()
+	assert.Contains(t, frame, "player1  (your turn)")
+	assert.Contains(t, frame, "player3  (out)")
+	assert.Contains(t, frame, "HP [##################--] 27/30")
+	assert.Contains(t, frame, " played this turn: 3\n")
+	assert.Contains(t, frame, "│ 5 │")
+	assert.Contains(t, frame, "HP [####################] 30/30")
+	assert.Contains(t, frame, "MP [########------------] 4/10")
+	assert.Contains(t, term.String(), clearScreen, "PrintStats redraws instead of printing")
+}
+
+func Test_bar(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int
+		max   int
+		want  string
+	}{
+		{name: "full", value: 10, max: 10, want: "[####################]"},
+		{name: "half", value: 15, max: 30, want: "[##########----------]"},
+		{name: "dead", value: -3, max: 30, want: "[--------------------]"},
+		{name: "over max", value: 12, max: 10, want: "[####################]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bar(tt.value, tt.max); got != tt.want {
+				t.Errorf("bar() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_commonPrefix(t *testing.T) {
+	assert.Equal(t, "d", commonPrefix([]string{"draw ", "decline "}))
+	assert.Equal(t, "play ", commonPrefix([]string{"play "}))
+}
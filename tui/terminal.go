@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// IsTerminal reports whether f is attached to a terminal rather than a pipe or file
+func IsTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+// Raw switches the terminal on stdin to unbuffered keypresses without echo,
+// the returned func puts it back how it was
+func Raw() (func(), error) {
+	saved, err := stty("-g")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stty("-icanon", "-echo", "min", "1"); err != nil {
+		return nil, err
+	}
+	return func() {
+		stty(strings.TrimSpace(saved))
+		os.Stdout.WriteString(showCursor)
+	}, nil
+}
+
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	b, err := cmd.Output()
+	return string(b), err
+}
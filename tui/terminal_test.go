@@ -0,0 +1,19 @@
+package tui
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestIsTerminal(t *testing.T) {
+	f, err := ioutil.TempFile("", "tui")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if IsTerminal(f) {
+		t.Errorf("IsTerminal() = true for a regular file")
+	}
+}
@@ -0,0 +1,162 @@
+package tutorial
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ShookieShookie/WorkshopImpl/command"
+	"github.com/ShookieShookie/WorkshopImpl/deck"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/ShookieShookie/WorkshopImpl/hand"
+	"github.com/ShookieShookie/WorkshopImpl/player"
+)
+
+const (
+	PlayerID   = "player1"
+	OpponentID = "tutor"
+)
+
+// Hero is one side of the tutorial game, the deck is drawn from the front
+type Hero struct {
+	Health int   `json:"health"`
+	Deck   []int `json:"deck"`
+}
+
+// Step explains something and waits for the expected command, with no Expect it only explains
+type Step struct {
+	Explain string `json:"explain"`
+	Expect  string `json:"expect"`
+}
+
+type Script struct {
+	Player   Hero `json:"player"`
+	Opponent Hero `json:"opponent"`
+	// OpponentInput is what the opponent types, once it runs out they end every turn
+	OpponentInput []string `json:"opponentInput"`
+	Steps         []Step   `json:"steps"`
+}
+
+// Load reads a tutorial script from a JSON data file
+func Load(path string) (*Script, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &Script{}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("reading tutorial %s: %v", path, err)
+	}
+	if err := s.validate(); err != nil {
+		return nil, fmt.Errorf("tutorial %s: %v", path, err)
+	}
+	return s, nil
+}
+
+func (s *Script) validate() error {
+	if s.Player.Health <= 0 || s.Opponent.Health <= 0 {
+		return fmt.Errorf("both heroes need positive health")
+	}
+	if len(s.Player.Deck) < 3 || len(s.Opponent.Deck) < 3 {
+		return fmt.Errorf("both decks need at least 3 cards")
+	}
+	for i, step := range s.Steps {
+		if step.Expect == "" {
+			continue
+		}
+		if _, err := command.Parse(step.Expect); err != nil {
+			return fmt.Errorf("step %d expects %q: %v", i+1, step.Expect, err)
+		}
+	}
+	for _, in := range s.OpponentInput {
+		if _, err := command.Parse(in); err != nil {
+			return fmt.Errorf("opponent input %q: %v", in, err)
+		}
+	}
+	return nil
+}
+
+// Tutorial walks player1 through a script, only letting them continue with the expected input
+type Tutorial struct {
+	script   *Script
+	step     int
+	opponent int
+	// explained is how many steps have been shown
+	explained int
+}
+
+func NewTutorial(script *Script) *Tutorial {
+	return &Tutorial{
+		script: script,
+	}
+}
+
+// NewGame seats player1 first against the scripted opponent
+func (t *Tutorial) NewGame(userInput func() string, turn game.TurnFunc) *game.Game {
+	return game.NewGame(newHero(PlayerID, t.script.Player), newHero(OpponentID, t.script.Opponent), userInput, turn)
+}
+
+// Done is whether every step has been completed
+func (t *Tutorial) Done() bool {
+	return t.step >= len(t.script.Steps)
+}
+
+// Wrap guides player1's input and plays the opponent's turns from the script
+func (t *Tutorial) Wrap(turn game.TurnFunc) game.TurnFunc {
+	return func(iter int, active game.Player, table *game.Table, getInput func() string) *game.Result {
+		if active.ID() == OpponentID {
+			return turn(iter, active, table, t.opponentInput)
+		}
+		return turn(iter, active, table, t.guided(getInput))
+	}
+}
+
+func (t *Tutorial) opponentInput() string {
+	if t.opponent >= len(t.script.OpponentInput) {
+		return "end"
+	}
+	t.opponent++
+	return t.script.OpponentInput[t.opponent-1]
+}
+
+// guided keeps asking until the input matches the current step
+func (t *Tutorial) guided(getInput func() string) func() string {
+	return func() string {
+		for !t.Done() {
+			step := t.script.Steps[t.step]
+			if t.explained == t.step {
+				fmt.Fprintln(game.Output(), step.Explain)
+				t.explained++
+			}
+			if step.Expect == "" {
+				t.step++
+				continue
+			}
+			s := getInput()
+			if s == game.Disconnected || matches(s, step.Expect) {
+				t.step++
+				return s
+			}
+			fmt.Fprintf(game.Output(), "Not quite, type %s to continue\n", step.Expect)
+		}
+		return getInput()
+	}
+}
+
+// matches compares parsed commands so aliases like p 0 count as play 0
+func matches(s, expect string) bool {
+	got, err := command.Parse(s)
+	if err != nil {
+		return false
+	}
+	want, _ := command.Parse(expect)
+	return got == want
+}
+
+func newHero(id string, h Hero) *player.PlayerImpl {
+	d := deck.NewOrderedDeck()
+	for _, c := range h.Deck {
+		d.Add(c)
+	}
+	return player.NewPlayer(id, h.Health, 0, hand.NewHand(), d)
+}
@@ -0,0 +1,126 @@
+package tutorial
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ShookieShookie/WorkshopImpl/command"
+	"github.com/ShookieShookie/WorkshopImpl/game"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestShippedTutorial plays the shipped script through the real rules, with a few wrong inputs on the way
+func TestShippedTutorial(t *testing.T) {
+	script, err := Load(filepath.Join("..", "data", "tutorial.json"))
+	assert.NoError(t, err)
+	input := []string{
+		"play 1", "p 0", "end",
+		"play 1", "play 0", "play 2", "hand", "end",
+		"play 2", "end",
+		"play 1",
+	}
+	i := 0
+	getInput := func() string {
+		i++
+		return input[i-1]
+	}
+	tut := NewTutorial(script)
+	result := tut.NewGame(getInput, tut.Wrap(command.Turn(game.NewRules(game.NewEventLog())))).Start()
+	assert.True(t, tut.Done())
+	assert.Equal(t, []string{PlayerID}, result.Winners)
+	assert.Equal(t, len(input), i)
+}
+
+func TestLoad(t *testing.T) {
+	heroes := `"player": {"health": 5, "deck": [1, 1, 1]}, "opponent": {"health": 5, "deck": [1, 1, 1]}`
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `{` + heroes + `, "steps": [{"explain": "hi", "expect": "end"}, {"explain": "bye"}]}`,
+		},
+		{
+			name:    "not json",
+			data:    `{`,
+			wantErr: "reading tutorial",
+		},
+		{
+			name:    "no health",
+			data:    `{"player": {"deck": [1, 1, 1]}, "opponent": {"health": 5, "deck": [1, 1, 1]}}`,
+			wantErr: "positive health",
+		},
+		{
+			name:    "small deck",
+			data:    `{"player": {"health": 5, "deck": [1]}, "opponent": {"health": 5, "deck": [1, 1, 1]}}`,
+			wantErr: "at least 3 cards",
+		},
+		{
+			name:    "bad expect",
+			data:    `{` + heroes + `, "steps": [{"explain": "hi", "expect": "dance"}]}`,
+			wantErr: `step 1 expects "dance"`,
+		},
+		{
+			name:    "bad opponent input",
+			data:    `{` + heroes + `, "opponentInput": ["dance"]}`,
+			wantErr: `opponent input "dance"`,
+		},
+	}
+	dir, err := ioutil.TempDir("", "tutorial")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "tutorial.json")
+			if err := ioutil.WriteFile(path, []byte(tt.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := Load(path)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTutorial_guided(t *testing.T) {
+	buf := &bytes.Buffer{}
+	game.SetOutput(buf)
+	defer game.SetOutput(os.Stdout)
+	tut := NewTutorial(&Script{Steps: []Step{
+		{Explain: "Play your card", Expect: "play 0"},
+		{Explain: "All done"},
+	}})
+	input := []string{"end", "p 0", "hand"}
+	getInput := func() string {
+		s := input[0]
+		input = input[1:]
+		return s
+	}
+	guided := tut.guided(getInput)
+
+	assert.Equal(t, "p 0", guided())
+	assert.False(t, tut.Done())
+	assert.Equal(t, "hand", guided())
+	assert.True(t, tut.Done())
+	assert.Equal(t, "Play your card\nNot quite, type play 0 to continue\nAll done\n", buf.String())
+}
+
+func TestTutorial_opponentInput(t *testing.T) {
+	tut := NewTutorial(&Script{OpponentInput: []string{"play 1"}})
+	assert.Equal(t, "play 1", tut.opponentInput())
+	assert.Equal(t, "end", tut.opponentInput())
+	assert.Equal(t, "end", tut.opponentInput())
+}